@@ -8,6 +8,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os/signal"
 	"syscall"
@@ -15,21 +16,33 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/pesimista/purolator-rest-api/internal/api/controller"
+	"github.com/pesimista/purolator-rest-api/internal/config"
 )
 
 type Server struct {
 	engine *gin.Engine
 	server *http.Server
+	config *config.Config
+	// cancel aborts the context of every in flight request,
+	// including the Purolator calls made with it.
+	cancel context.CancelFunc
 }
 
-func NewServer() *Server {
+func NewServer(cfg *config.Config) *Server {
 	handler := gin.New()
+	baseCtx, cancel := context.WithCancel(context.Background())
+
 	return &Server{
 		engine: handler,
+		config: cfg,
+		cancel: cancel,
 		server: &http.Server{
-			Addr:              "localhost:8080",
+			Addr:              cfg.Server.Address,
 			Handler:           handler,
 			ReadHeaderTimeout: time.Second * 30,
+			BaseContext: func(net.Listener) context.Context {
+				return baseCtx
+			},
 		},
 	}
 }
@@ -43,8 +56,8 @@ func NewServer() *Server {
 // 	})
 // }
 
-func (s *Server) SetRoutes() {
-	controller.NewRouter(s.engine)
+func (s *Server) SetRoutes() error {
+	return controller.NewRouter(s.engine, s.config)
 }
 
 func (s *Server) Run() {
@@ -69,6 +82,9 @@ func (s *Server) Run() {
 		fmt.Printf("listen: %s", err)
 	}
 
+	// Requests still running after the grace period are cancelled.
+	s.cancel()
+
 	fmt.Println("App Exiting")
 
 }
@@ -1,16 +1,24 @@
 package controller
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-openapi/runtime/middleware"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
 	"github.com/pesimista/purolator-rest-api/internal/api/handlers"
+	"github.com/pesimista/purolator-rest-api/internal/api/idempotency"
 	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/repository"
 	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+	"github.com/pesimista/purolator-rest-api/internal/api/validation"
+	"github.com/pesimista/purolator-rest-api/internal/config"
 )
 
-func NewRouter(handler *gin.Engine) {
+func NewRouter(handler *gin.Engine, cfg *config.Config) error {
+	const op string = "controller.NewRouter"
+
 	handler.Use(gin.Logger())
 	handler.Use(gin.Recovery())
 	// handler.Use(middleware.())
@@ -25,22 +33,87 @@ func NewRouter(handler *gin.Engine) {
 	opt := openapi.GinServerOptions{
 		BaseURL:     "/api/v1",
 		Middlewares: make([]openapi.MiddlewareFunc, 0),
+		ErrorHandler: func(ctx *gin.Context, err error, statusCode int) {
+			cErrors.JSON(ctx, "controller.RegisterHandlers", "", err, statusCode)
+		},
+	}
+
+	swagger, err := openapi.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	validator, err := validation.NewValidator(swagger, opt.BaseURL)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	handler.Use(validator.Response)
+	opt.Middlewares = append(opt.Middlewares, validator.Request)
+
+	httpClient := soap.NewBreakerClient(&http.Client{}, soap.DefaultBreakerSettings)
+	client := soap.NewSoapClient(
+		cfg.Purolator.Key,
+		cfg.Purolator.Secret,
+		httpClient,
+		soap.WithBaseURL(cfg.Purolator.BaseURL),
+		soap.WithGroupID(cfg.Purolator.GroupID),
+		soap.WithTimeouts(soap.Timeouts(cfg.Purolator.Timeouts)),
+		soap.WithUnits(soap.Units(cfg.Purolator.Units)),
+	)
+
+	store, err := newIdempotencyStore(cfg.Idempotency)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	httpClient := &http.Client{}
-	client := soap.NewSoapClient("f1d4907b025a4e17bf78a0954f099de5", "I4M.LRIN", httpClient)
-	RegisterHandlers(handler, handlers.NewServer(client), opt)
+	shipments, err := repository.NewSQLiteRepository(cfg.Database.Path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	RegisterHandlers(handler, handlers.NewServer(client, cfg.Purolator.BillingAccount, httpClient, store, shipments), opt)
+	return nil
+}
+
+func newIdempotencyStore(cfg config.Idempotency) (idempotency.Store, error) {
+	if cfg.Store == config.FileStore {
+		return idempotency.NewFileStore(cfg.Path, cfg.TTL)
+	}
+
+	return idempotency.NewMemoryStore(cfg.TTL), nil
 }
 
 func RegisterHandlers(router *gin.Engine, si openapi.ServerInterface, options openapi.GinServerOptions) *gin.Engine {
 	wrapper := openapi.ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       options.ErrorHandler,
 	}
 
-	router.GET(options.BaseURL+"/shipments", func(ctx *gin.Context) { ctx.JSON(200, gin.H{"msg": "hello"}) })
+	router.GET(options.BaseURL+"/shipments", wrapper.ListShipments)
 	router.POST(options.BaseURL+"/shipments", wrapper.CreateShipment)
+	router.GET(options.BaseURL+"/shipments/:trackingNo", wrapper.GetDocument)
 	router.DELETE(options.BaseURL+"/shipments/:trackingNo", wrapper.VoidShipment)
+	router.GET(options.BaseURL+"/shipments/:trackingNo/details", wrapper.GetShipmentDetails)
+	router.GET(options.BaseURL+"/shipments/:trackingNo/tracking", wrapper.TrackShipment)
+	router.GET(options.BaseURL+"/tracking", wrapper.TrackByReference)
+	router.POST(options.BaseURL+"/rates", wrapper.GetRates)
+	router.GET(options.BaseURL+"/services", wrapper.ListServices)
+	router.GET(options.BaseURL+"/locations", wrapper.ListLocations)
+	// gin reads the colon as the start of a wildcard, so only :validate is let through
+	router.POST(options.BaseURL+"/addresses:action", func(c *gin.Context) {
+		if c.Param("action") != ":validate" {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		wrapper.ValidateAddresses(c)
+	})
+	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
+	router.GET(options.BaseURL+"/pickups", wrapper.GetPickupHistory)
+	router.POST(options.BaseURL+"/pickups", wrapper.SchedulePickup)
+	router.PATCH(options.BaseURL+"/pickups/:confirmationNo", wrapper.ModifyPickup)
+	router.DELETE(options.BaseURL+"/pickups/:confirmationNo", wrapper.VoidPickup)
 
 	return router
 }
@@ -1,11 +1,15 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
 )
 
 // type defaultMessage struct {
@@ -20,8 +24,61 @@ func JSON(c *gin.Context, operation, message string, err error, httpCode int) {
 	}
 
 	fmt.Printf("%s: %s: %s\n", operation, message, err)
-	c.JSON(httpCode, openapi.Error{
+
+	response := openapi.Error{
 		Code:    httpCode,
 		Message: msg,
-	})
+	}
+
+	var soapErr *soap.Error
+	if errors.As(err, &soapErr) {
+		details := newErrorDetails(soapErr)
+		response.Errors = &details
+
+		if len(soapErr.ResponseReference) > 0 {
+			response.Reference = &soapErr.ResponseReference
+		}
+	}
+
+	// errors found before reaching Purolator may list their own details
+	var detailsErr interface{ Details() []openapi.ErrorDetail }
+	if errors.As(err, &detailsErr) {
+		details := detailsErr.Details()
+		response.Errors = &details
+	}
+
+	var circuitErr *soap.CircuitOpenError
+	if errors.As(err, &circuitErr) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(circuitErr.RetryAfter.Seconds()))))
+	}
+
+	c.JSON(httpCode, response)
+}
+
+// newErrorDetails lists the soap fault, if any, followed by every Purolator error.
+func newErrorDetails(soapErr *soap.Error) []openapi.ErrorDetail {
+	details := make([]openapi.ErrorDetail, 0, len(soapErr.Errors)+1)
+
+	if len(soapErr.FaultCode) > 0 || len(soapErr.FaultString) > 0 {
+		details = append(details, openapi.ErrorDetail{
+			Code:        soapErr.FaultCode,
+			Description: soapErr.FaultString,
+		})
+	}
+
+	for _, responseError := range soapErr.Errors {
+		detail := openapi.ErrorDetail{
+			Code:        responseError.Code,
+			Description: responseError.Description,
+		}
+
+		if len(responseError.AdditionalInformation) > 0 {
+			additionalInformation := responseError.AdditionalInformation
+			detail.AdditionalInformation = &additionalInformation
+		}
+
+		details = append(details, detail)
+	}
+
+	return details
 }
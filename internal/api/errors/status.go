@@ -0,0 +1,101 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+// purolatorCodes maps the Purolator error codes we have seen Purolator return, with the
+// responses kept in the fixtures of soap/shipments_test.go, soap/documents_test.go and
+// soap/errors_test.go, to the HTTP status returned to our callers. Codes missing here are
+// treated as validation errors, which is what most of the Purolator business errors are.
+var purolatorCodes = map[string]int{
+	// Shipments
+	"1100759": http.StatusUnprocessableEntity, // Invalid Shipment Date
+	"1100429": http.StatusConflict,            // You can only cancel shipments created today
+	"3001203": http.StatusNotFound,            // Invalid PIN
+
+	// Documents
+	"1100546": http.StatusNotFound, // PIN not found
+}
+
+// Status classifies the error returned by the soap client into the HTTP status
+// for our response, so callers can tell their own mistakes apart from outages.
+func Status(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	switch {
+	case errors.Is(err, soap.ErrMissingTrackingNumber),
+		errors.Is(err, soap.ErrMissingReference),
+		errors.Is(err, soap.ErrMissingConfirmationNumber),
+		errors.Is(err, soap.ErrInvalidRequestBody):
+		return http.StatusBadRequest
+	case errors.Is(err, soap.ErrCircuitOpen):
+		return http.StatusServiceUnavailable
+	case isTimeout(err):
+		return http.StatusGatewayTimeout
+	}
+
+	var soapErr *soap.Error
+	if errors.As(err, &soapErr) {
+		return soapStatus(soapErr)
+	}
+
+	switch {
+	case errors.Is(err, soap.ErrFailedRequest),
+		errors.Is(err, soap.ErrInvalidResponseBody),
+		errors.Is(err, soap.ErrInvalidXML),
+		errors.Is(err, soap.ErrFailedDownload):
+		return http.StatusBadGateway
+	}
+
+	return http.StatusInternalServerError
+}
+
+func soapStatus(soapErr *soap.Error) int {
+	switch soapErr.HTTPStatus {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		// Our credentials were rejected, nothing the caller can fix.
+		return http.StatusBadGateway
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return http.StatusGatewayTimeout
+	}
+
+	if len(soapErr.FaultCode) > 0 {
+		// The request did not match the Purolator schema.
+		if strings.HasSuffix(soapErr.FaultCode, "Client") {
+			return http.StatusUnprocessableEntity
+		}
+
+		return http.StatusBadGateway
+	}
+
+	if len(soapErr.Errors) == 0 {
+		return http.StatusBadGateway
+	}
+
+	// When several errors are returned, the first known one wins.
+	for _, responseError := range soapErr.Errors {
+		if status, ok := purolatorCodes[responseError.Code]; ok {
+			return status
+		}
+	}
+
+	return http.StatusUnprocessableEntity
+}
+
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,120 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+func Test_Status(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{
+			name: "When a required value is missing, return 400",
+			err:  fmt.Errorf("soap.VoidShipment: %w", soap.ErrMissingTrackingNumber),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "When the circuit of the service is open, return 503",
+			err:  fmt.Errorf("soap.HttpRequest: %w %w", soap.ErrFailedRequest, &soap.CircuitOpenError{Service: "shipping"}),
+			want: http.StatusServiceUnavailable,
+		},
+		{
+			name: "When the request times out, return 504",
+			err:  fmt.Errorf("soap.HttpRequest: %w %w", soap.ErrFailedRequest, os.ErrDeadlineExceeded),
+			want: http.StatusGatewayTimeout,
+		},
+		{
+			name: "When the context deadline is exceeded, return 504",
+			err:  fmt.Errorf("soap.HttpRequest: %w %w", soap.ErrFailedRequest, context.DeadlineExceeded),
+			want: http.StatusGatewayTimeout,
+		},
+		{
+			name: "When the request fails, return 502",
+			err:  fmt.Errorf("soap.HttpRequest: %w %w", soap.ErrFailedRequest, errors.New("connection refused")),
+			want: http.StatusBadGateway,
+		},
+		{
+			name: "When the response is not valid XML, return 502",
+			err:  fmt.Errorf("soap.CreateShipment: %w", soap.ErrInvalidXML),
+			want: http.StatusBadGateway,
+		},
+		{
+			name: "When the credentials are rejected, return 502",
+			err:  &soap.Error{HTTPStatus: http.StatusUnauthorized},
+			want: http.StatusBadGateway,
+		},
+		{
+			name: "When the request does not match the schema, return 422",
+			err:  &soap.Error{HTTPStatus: http.StatusInternalServerError, FaultCode: "s:Client"},
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "When Purolator fails on its side, return 502",
+			err:  &soap.Error{HTTPStatus: http.StatusInternalServerError, FaultCode: "s:Server"},
+			want: http.StatusBadGateway,
+		},
+		{
+			name: "When the shipment was not created today, return 409",
+			err: fmt.Errorf("soap.VoidShipment: %w", &soap.Error{
+				HTTPStatus: http.StatusOK,
+				Errors:     []models.ResponseError{{Code: "1100429", Description: "You can only cancel shipments created today."}},
+			}),
+			want: http.StatusConflict,
+		},
+		{
+			name: "When the PIN of the documents is not found, return 404",
+			err: fmt.Errorf("soap.GetDocuments: %w", &soap.Error{
+				HTTPStatus: http.StatusOK,
+				Errors:     []models.ResponseError{{Code: "1100546", Description: "PIN not found"}},
+			}),
+			want: http.StatusNotFound,
+		},
+		{
+			name: "When the PIN to void is invalid, return 404",
+			err: fmt.Errorf("soap.VoidShipment: %w", &soap.Error{
+				HTTPStatus: http.StatusOK,
+				Errors:     []models.ResponseError{{Code: "3001203", Description: "Invalid PIN"}},
+			}),
+			want: http.StatusNotFound,
+		},
+		{
+			name: "When several errors are returned, the first known one wins",
+			err: &soap.Error{
+				HTTPStatus: http.StatusOK,
+				Errors:     []models.ResponseError{{Code: "9999999"}, {Code: "1100429"}, {Code: "1100759"}},
+			},
+			want: http.StatusConflict,
+		},
+		{
+			name: "When the error code is unknown, return 422",
+			err: &soap.Error{
+				HTTPStatus: http.StatusOK,
+				Errors:     []models.ResponseError{{Code: "9999999"}},
+			},
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "When the error is unexpected, return 500",
+			err:  errors.New("unexpected"),
+			want: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Status(tt.err); got != tt.want {
+				t.Errorf("Status() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,429 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+const (
+	invalidAddress = "InvalidAddress"
+	unitedStates   = "US"
+)
+
+var errInvalidAddress = errors.New("invalid address")
+
+var (
+	canadianPostalCode = regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] \d[ABCEGHJ-NPRSTV-Z]\d$`)
+	zipCode            = regexp.MustCompile(`^\d{5}(-\d{4})?$`)
+	poBox              = regexp.MustCompile(`(?i)^(?:P\.?\s*O\.?|POST\s+OFFICE)\s*BOX\s*#?\s*(\w+)$`)
+	// the unit keyword must be a whole word followed by a space or #, or directly by the
+	// unit number when it starts with a digit, so streets like Rue Stevens are left alone
+	unitInStreet = regexp.MustCompile(`(?i)^(.+?)[\s,]+(?:(?:(?:UNIT|SUITE|STE|APT)\b\.?(?:\s*#\s*|\s+)|#\s*)([A-Z0-9]+)|(?:UNIT|SUITE|STE|APT)\.?(\d[A-Z0-9]*))$`)
+	// only used on Canadian addresses, US street numbers like 123-45 have a dash of their own
+	unitInNumber = regexp.MustCompile(`^([A-Za-z0-9]+)\s*-\s*(\d+[A-Za-z]?)$`)
+)
+
+var countryNames = map[string]string{
+	"CAN":           domesticCountry,
+	"CANADA":        domesticCountry,
+	"USA":           unitedStates,
+	"UNITED STATES": unitedStates,
+}
+
+var provinceNames = map[string]string{
+	"ALBERTA":                   "AB",
+	"BRITISH COLUMBIA":          "BC",
+	"MANITOBA":                  "MB",
+	"NEW BRUNSWICK":             "NB",
+	"NEWFOUNDLAND AND LABRADOR": "NL",
+	"NOVA SCOTIA":               "NS",
+	"NORTHWEST TERRITORIES":     "NT",
+	"NUNAVUT":                   "NU",
+	"ONTARIO":                   "ON",
+	"PRINCE EDWARD ISLAND":      "PE",
+	"QUEBEC":                    "QC",
+	"SASKATCHEWAN":              "SK",
+	"YUKON":                     "YT",
+}
+
+// postalProvinces are the provinces of each first letter of a Canadian postal code.
+var postalProvinces = map[byte][]string{
+	'A': {"NL"}, 'B': {"NS"}, 'C': {"PE"}, 'E': {"NB"},
+	'G': {"QC"}, 'H': {"QC"}, 'J': {"QC"},
+	'K': {"ON"}, 'L': {"ON"}, 'M': {"ON"}, 'N': {"ON"}, 'P': {"ON"},
+	'R': {"MB"}, 'S': {"SK"}, 'T': {"AB"}, 'V': {"BC"},
+	'X': {"NT", "NU"}, 'Y': {"YT"},
+}
+
+var states = []string{
+	"AK", "AL", "AR", "AZ", "CA", "CO", "CT", "DC", "DE", "FL", "GA", "HI", "IA", "ID", "IL", "IN", "KS",
+	"KY", "LA", "MA", "MD", "ME", "MI", "MN", "MO", "MS", "MT", "NC", "ND", "NE", "NH", "NJ", "NM", "NV",
+	"NY", "OH", "OK", "OR", "PA", "PR", "RI", "SC", "SD", "TN", "TX", "UT", "VA", "VT", "WA", "WI", "WV", "WY",
+}
+
+// addressError lists the problems found on the addresses, with the suggested corrections.
+type addressError struct {
+	details []openapi.ErrorDetail
+}
+
+func (e *addressError) Error() string {
+	problems := make([]string, 0, len(e.details))
+	for _, detail := range e.details {
+		problems = append(problems, stringValue(detail.Field)+" "+detail.Description)
+	}
+
+	return fmt.Sprintf("%s: %s", errInvalidAddress, strings.Join(problems, ", "))
+}
+
+func (e *addressError) Unwrap() error {
+	return errInvalidAddress
+}
+
+// Details are added to the error response by cErrors.JSON.
+func (e *addressError) Details() []openapi.ErrorDetail {
+	return e.details
+}
+
+func (s *server) ValidateAddresses(c *gin.Context) {
+	const op string = "handlers.ValidateAddresses"
+
+	var request *openapi.AddressValidationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		cErrors.JSON(c, op, "could not bind request body", err, http.StatusBadRequest)
+		return
+	}
+
+	addresses := make([]openapi.Address, 0, len(request.Addresses))
+	problems := make([][]openapi.ErrorDetail, 0, len(request.Addresses))
+	for _, address := range request.Addresses {
+		address = normalizeAddress(address)
+		addresses = append(addresses, address)
+		problems = append(problems, checkAddress(address))
+	}
+
+	remote, err := s.checkCityPostalCode(c.Request.Context(), addresses, problems)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	results := make([]openapi.AddressValidationResult, 0, len(addresses))
+	for i, address := range addresses {
+		errs := append(problems[i], remote[i]...)
+		results = append(results, openapi.AddressValidationResult{
+			Valid:   len(errs) == 0,
+			Address: address,
+			Errors:  errs,
+		})
+	}
+
+	c.JSON(http.StatusOK, openapi.AddressValidationRes{Results: results})
+}
+
+// normalizeShipmentAddresses normalizes the sender and receiver addresses in place,
+// failing with every problem found on them.
+func normalizeShipmentAddresses(shipment *openapi.CreateShipmentRequest) error {
+	const op string = "handlers.normalizeShipmentAddresses"
+
+	sender := &shipment.Shipment.SenderInformation.Address
+	receiver := &shipment.Shipment.ReceiverInformation.Address
+
+	*sender = normalizeAddress(*sender)
+	*receiver = normalizeAddress(*receiver)
+
+	details := append(
+		withPrefix("shipment/senderInformation/address/", checkAddress(*sender)),
+		withPrefix("shipment/receiverInformation/address/", checkAddress(*receiver))...,
+	)
+	if len(details) > 0 {
+		return fmt.Errorf("%s: %w", op, &addressError{details: details})
+	}
+
+	return nil
+}
+
+// checkShipmentCities asks Purolator whether the city of the sender and the receiver
+// matches their postal code. Purolator checks them again when creating the shipment,
+// so a failure of the check itself does not stop it.
+func (s *server) checkShipmentCities(ctx context.Context, shipment *openapi.CreateShipmentRequest) error {
+	const op string = "handlers.checkShipmentCities"
+
+	addresses := []openapi.Address{
+		shipment.Shipment.SenderInformation.Address,
+		shipment.Shipment.ReceiverInformation.Address,
+	}
+
+	remote, err := s.checkCityPostalCode(ctx, addresses, make([][]openapi.ErrorDetail, len(addresses)))
+	if err != nil {
+		fmt.Printf("%s: %s\n", op, err)
+		return nil
+	}
+
+	details := append(
+		withPrefix("shipment/senderInformation/address/", remote[0]),
+		withPrefix("shipment/receiverInformation/address/", remote[1])...,
+	)
+	if len(details) > 0 {
+		return fmt.Errorf("%s: %w", op, &addressError{details: details})
+	}
+
+	return nil
+}
+
+// checkCityPostalCode runs the Canadian and US addresses without local problems through
+// ValidateCityPostalCodeZip, returning the problems of each address.
+func (s *server) checkCityPostalCode(ctx context.Context, addresses []openapi.Address, problems [][]openapi.ErrorDetail) ([][]openapi.ErrorDetail, error) {
+	const op string = "handlers.checkCityPostalCode"
+
+	remote := make([][]openapi.ErrorDetail, len(addresses))
+
+	indexes := make([]int, 0, len(addresses))
+	shortAddresses := make([]models.ShortAddress, 0, len(addresses))
+	for i, address := range addresses {
+		if len(problems[i]) > 0 || (address.Country != domesticCountry && address.Country != unitedStates) {
+			continue
+		}
+
+		indexes = append(indexes, i)
+		shortAddresses = append(shortAddresses, models.ShortAddress{
+			City:       address.City,
+			Province:   address.Province,
+			Country:    address.Country,
+			PostalCode: address.PostalCode,
+		})
+	}
+
+	if len(shortAddresses) == 0 {
+		return remote, nil
+	}
+
+	data, err := s.client.ValidateCityPostalCodeZip(ctx, shortAddresses)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for j, suggested := range data.SuggestedAddresses {
+		if j >= len(indexes) {
+			break
+		}
+
+		remote[indexes[j]] = suggestedProblems(shortAddresses[j], suggested)
+	}
+
+	return remote, nil
+}
+
+// suggestedProblems points to each field that differs from the suggested address,
+// when Purolator rejected the city and postal code.
+func suggestedProblems(address models.ShortAddress, suggested models.SuggestedAddress) []openapi.ErrorDetail {
+	if len(suggested.ResponseInformation.Errors) == 0 {
+		return nil
+	}
+
+	details := make([]openapi.ErrorDetail, 0)
+	suggest := func(field, value, suggestion string) {
+		if len(suggestion) == 0 || strings.EqualFold(compact(value), compact(suggestion)) {
+			return
+		}
+
+		details = append(details, openapi.ErrorDetail{
+			Code:        invalidAddress,
+			Description: "does not match the rest of the address",
+			Field:       &field,
+			Suggestion:  &suggestion,
+		})
+	}
+
+	suggest("city", address.City, suggested.Address.City)
+	suggest("province", address.Province, suggested.Address.Province)
+	suggest("postalCode", address.PostalCode, normalizeAddress(openapi.Address{
+		PostalCode: suggested.Address.PostalCode,
+		Country:    suggested.Address.Country,
+	}).PostalCode)
+
+	if len(details) > 0 {
+		return details
+	}
+
+	field := "city"
+	for _, responseError := range suggested.ResponseInformation.Errors {
+		details = append(details, openapi.ErrorDetail{
+			Code:        responseError.Code,
+			Description: responseError.Description,
+			Field:       &field,
+		})
+	}
+
+	return details
+}
+
+// normalizeAddress fixes the spacing and casing of the address, formats its postal code,
+// PO box and province, and moves the unit written on the street to the suite.
+func normalizeAddress(address openapi.Address) openapi.Address {
+	address.Name = collapse(address.Name)
+	address.StreetNumber = collapse(address.StreetNumber)
+	address.StreetName = collapse(address.StreetName)
+	address.City = recase(collapse(address.City))
+	address.Country = strings.ToUpper(collapse(address.Country))
+	address.Province = strings.ToUpper(collapse(address.Province))
+
+	if address.Company != nil {
+		company := collapse(*address.Company)
+		address.Company = &company
+	}
+
+	if country, ok := countryNames[address.Country]; ok {
+		address.Country = country
+	}
+
+	if address.Country == domesticCountry {
+		if province, ok := provinceNames[address.Province]; ok {
+			address.Province = province
+		}
+	}
+
+	switch address.Country {
+	case domesticCountry:
+		address.PostalCode = strings.ToUpper(compact(address.PostalCode))
+		if len(address.PostalCode) == 6 {
+			address.PostalCode = address.PostalCode[:3] + " " + address.PostalCode[3:]
+		}
+	case unitedStates:
+		address.PostalCode = compact(address.PostalCode)
+		if len(address.PostalCode) == 9 && !strings.Contains(address.PostalCode, "-") {
+			address.PostalCode = address.PostalCode[:5] + "-" + address.PostalCode[5:]
+		}
+	default:
+		address.PostalCode = strings.ToUpper(collapse(address.PostalCode))
+	}
+
+	if match := poBox.FindStringSubmatch(address.StreetName); match != nil {
+		address.StreetName = "PO BOX " + strings.ToUpper(match[1])
+		return address
+	}
+
+	if address.Suite == nil || len(strings.TrimSpace(*address.Suite)) == 0 {
+		if match := unitInNumber.FindStringSubmatch(address.StreetNumber); match != nil && address.Country == domesticCountry {
+			address.Suite, address.StreetNumber = &match[1], match[2]
+		} else if match := unitInStreet.FindStringSubmatch(address.StreetName); match != nil {
+			suite := match[2] + match[3]
+			address.StreetName, address.Suite = match[1], &suite
+		}
+	}
+
+	address.StreetName = recase(address.StreetName)
+
+	return address
+}
+
+// checkAddress returns the problems of a normalized address, only Canadian
+// and US addresses are checked.
+func checkAddress(address openapi.Address) []openapi.ErrorDetail {
+	details := make([]openapi.ErrorDetail, 0)
+	problem := func(field, description, suggestion string) {
+		detail := openapi.ErrorDetail{Code: invalidAddress, Description: description, Field: &field}
+		if len(suggestion) > 0 {
+			detail.Suggestion = &suggestion
+		}
+		details = append(details, detail)
+	}
+
+	if address.Country != domesticCountry && address.Country != unitedStates {
+		return details
+	}
+
+	if len(address.City) == 0 {
+		problem("city", "is required", "")
+	}
+
+	if address.Country == unitedStates {
+		if !zipCode.MatchString(address.PostalCode) {
+			problem("postalCode", "must be a ZIP code like 12345 or 12345-6789", "")
+		}
+
+		if !slices.Contains(states, address.Province) {
+			problem("province", "must be the code of a US state", "")
+		}
+
+		return details
+	}
+
+	validPostalCode := canadianPostalCode.MatchString(address.PostalCode)
+	if !validPostalCode {
+		problem("postalCode", "must be a Canadian postal code like A1A 1A1", "")
+	}
+
+	if !slices.Contains(provinceCodes(), address.Province) {
+		problem("province", "must be the code of a Canadian province", "")
+		return details
+	}
+
+	if validPostalCode {
+		provinces := postalProvinces[address.PostalCode[0]]
+		if !slices.Contains(provinces, address.Province) {
+			suggestion := ""
+			if len(provinces) == 1 {
+				suggestion = provinces[0]
+			}
+			problem("province", fmt.Sprintf("does not match the postal code %s", address.PostalCode), suggestion)
+		}
+	}
+
+	return details
+}
+
+func provinceCodes() []string {
+	codes := make([]string, 0, len(provinceNames))
+	for _, code := range provinceNames {
+		codes = append(codes, code)
+	}
+
+	return codes
+}
+
+func withPrefix(prefix string, details []openapi.ErrorDetail) []openapi.ErrorDetail {
+	for i, detail := range details {
+		field := prefix + stringValue(detail.Field)
+		details[i].Field = &field
+	}
+
+	return details
+}
+
+// collapse trims the value and leaves a single space between its words.
+func collapse(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// compact removes every space of the value.
+func compact(value string) string {
+	return strings.Join(strings.Fields(value), "")
+}
+
+// recase capitalizes each word of a value written all in upper or lower case,
+// values with mixed case are left as they were written.
+func recase(value string) string {
+	if value != strings.ToUpper(value) && value != strings.ToLower(value) {
+		return value
+	}
+
+	runes := []rune(strings.ToLower(value))
+	for i, r := range runes {
+		if i == 0 || runes[i-1] == ' ' || runes[i-1] == '-' {
+			runes[i] = unicode.ToUpper(r)
+		}
+	}
+
+	return string(runes)
+}
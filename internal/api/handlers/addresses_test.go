@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+const cityMismatchXML = `<s:Envelope>
+	<s:Body>
+		<ValidateCityPostalCodeZipResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<SuggestedAddresses>
+				<SuggestedAddress>
+					<Address>
+						<City>Mississauga</City>
+						<Province>ON</Province>
+						<Country>CA</Country>
+						<PostalCode>L4W5M8</PostalCode>
+					</Address>
+					<ResponseInformation>
+						<Errors/>
+					</ResponseInformation>
+				</SuggestedAddress>
+				<SuggestedAddress>
+					<Address>
+						<City>Burnaby</City>
+						<Province>BC</Province>
+						<Country>CA</Country>
+						<PostalCode>V5C5A9</PostalCode>
+					</Address>
+					<ResponseInformation>
+						<Errors>
+							<Error>
+								<Code>1100525</Code>
+								<Description>Invalid City/Postal Code combination</Description>
+							</Error>
+						</Errors>
+					</ResponseInformation>
+				</SuggestedAddress>
+			</SuggestedAddresses>
+		</ValidateCityPostalCodeZipResponse>
+	</s:Body>
+</s:Envelope>`
+
+func Test_normalizeAddress(t *testing.T) {
+	unit := func(value string) *string { return &value }
+
+	testCases := []struct {
+		name string
+		args openapi.Address
+		want openapi.Address
+	}{
+		{
+			name: "When the address is written in lower case, fix the casing and spacing",
+			args: openapi.Address{Name: " Aaron  Summer ", StreetNumber: "5280", StreetName: "solar  drive", City: "mississauga", Province: "on", Country: "ca", PostalCode: "l4w 5m8"},
+			want: openapi.Address{Name: "Aaron Summer", StreetNumber: "5280", StreetName: "Solar Drive", City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W 5M8"},
+		},
+		{
+			name: "When the province and country are written in full, use their codes",
+			args: openapi.Address{StreetNumber: "2245", StreetName: "Douglas Road", City: "Burnaby", Province: "British Columbia", Country: "Canada", PostalCode: "V5C5A9"},
+			want: openapi.Address{StreetNumber: "2245", StreetName: "Douglas Road", City: "Burnaby", Province: "BC", Country: "CA", PostalCode: "V5C 5A9"},
+		},
+		{
+			name: "When the unit is part of the street number, move it to the suite",
+			args: openapi.Address{StreetNumber: "12-5280", StreetName: "Solar Drive", City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W5M8"},
+			want: openapi.Address{StreetNumber: "5280", StreetName: "Solar Drive", Suite: unit("12"), City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W 5M8"},
+		},
+		{
+			name: "When the unit is part of the street name, move it to the suite",
+			args: openapi.Address{StreetNumber: "5280", StreetName: "Solar Drive, Suite 200", City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W5M8"},
+			want: openapi.Address{StreetNumber: "5280", StreetName: "Solar Drive", Suite: unit("200"), City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W 5M8"},
+		},
+		{
+			name: "When the street is a PO box, format it",
+			args: openapi.Address{StreetNumber: "1", StreetName: "p.o. box 1234", City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W5M8"},
+			want: openapi.Address{StreetNumber: "1", StreetName: "PO BOX 1234", City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W 5M8"},
+		},
+		{
+			name: "When the street only starts like a unit keyword, keep the street as written",
+			args: openapi.Address{StreetNumber: "10", StreetName: "Rue Stevens", City: "Gatineau", Province: "QC", Country: "CA", PostalCode: "J8T5M6"},
+			want: openapi.Address{StreetNumber: "10", StreetName: "Rue Stevens", City: "Gatineau", Province: "QC", Country: "CA", PostalCode: "J8T 5M6"},
+		},
+		{
+			name: "When the street name contains Ste, keep the street as written",
+			args: openapi.Address{StreetNumber: "44", StreetName: "Avenue Stephen", City: "Montreal", Province: "QC", Country: "CA", PostalCode: "H2X1Y4"},
+			want: openapi.Address{StreetNumber: "44", StreetName: "Avenue Stephen", City: "Montreal", Province: "QC", Country: "CA", PostalCode: "H2X 1Y4"},
+		},
+		{
+			name: "When the street name contains Apt, keep the street as written",
+			args: openapi.Address{StreetNumber: "7", StreetName: "Chemin Apton", City: "Sherbrooke", Province: "QC", Country: "CA", PostalCode: "J1H5N4"},
+			want: openapi.Address{StreetNumber: "7", StreetName: "Chemin Apton", City: "Sherbrooke", Province: "QC", Country: "CA", PostalCode: "J1H 5N4"},
+		},
+		{
+			name: "When the street name contains Unit, keep the street as written",
+			args: openapi.Address{StreetNumber: "300", StreetName: "Boul Unity", City: "Laval", Province: "QC", Country: "CA", PostalCode: "H7N5B1"},
+			want: openapi.Address{StreetNumber: "300", StreetName: "Boul Unity", City: "Laval", Province: "QC", Country: "CA", PostalCode: "H7N 5B1"},
+		},
+		{
+			name: "When the unit follows the keyword without a space, move it to the suite",
+			args: openapi.Address{StreetNumber: "5280", StreetName: "Solar Drive Apt5", City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W5M8"},
+			want: openapi.Address{StreetNumber: "5280", StreetName: "Solar Drive", Suite: unit("5"), City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W 5M8"},
+		},
+		{
+			name: "When a US street number has a dash, keep it as the street number",
+			args: openapi.Address{StreetNumber: "123-45", StreetName: "Queens Blvd", City: "Forest Hills", Province: "NY", Country: "US", PostalCode: "11375"},
+			want: openapi.Address{StreetNumber: "123-45", StreetName: "Queens Blvd", City: "Forest Hills", Province: "NY", Country: "US", PostalCode: "11375"},
+		},
+		{
+			name: "When the ZIP code has nine digits, add the dash",
+			args: openapi.Address{StreetNumber: "1600", StreetName: "Amphitheatre Parkway", City: "Mountain View", Province: "ca", Country: "USA", PostalCode: "940431351"},
+			want: openapi.Address{StreetNumber: "1600", StreetName: "Amphitheatre Parkway", City: "Mountain View", Province: "CA", Country: "US", PostalCode: "94043-1351"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeAddress(tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeAddress() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkAddress(t *testing.T) {
+	type problem struct {
+		field      string
+		suggestion string
+	}
+
+	testCases := []struct {
+		name string
+		args openapi.Address
+		want []problem
+	}{
+		{
+			name: "When the Canadian address is valid, pass",
+			args: openapi.Address{City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W 5M8"},
+			want: []problem{},
+		},
+		{
+			name: "When the postal code is not Canadian, fail on it",
+			args: openapi.Address{City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "12345"},
+			want: []problem{{field: "postalCode"}},
+		},
+		{
+			name: "When the province does not match the postal code, suggest the right one",
+			args: openapi.Address{City: "Mississauga", Province: "BC", Country: "CA", PostalCode: "L4W 5M8"},
+			want: []problem{{field: "province", suggestion: "ON"}},
+		},
+		{
+			name: "When the US state and ZIP code are invalid, fail on both",
+			args: openapi.Address{City: "Mountain View", Province: "ON", Country: "US", PostalCode: "9404"},
+			want: []problem{{field: "postalCode"}, {field: "province"}},
+		},
+		{
+			name: "When the address is outside Canada and the US, do not check it",
+			args: openapi.Address{City: "London", Province: "", Country: "GB", PostalCode: "SW1A 1AA"},
+			want: []problem{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]problem, 0)
+			for _, detail := range checkAddress(tt.args) {
+				got = append(got, problem{field: stringValue(detail.Field), suggestion: stringValue(detail.Suggestion)})
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkAddress() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ValidateAddresses(t *testing.T) {
+	client := soap.NewSoapClient("key", "secret", &ActionHttpClient{bodies: map[string]string{
+		"ValidateCityPostalCodeZip": cityMismatchXML,
+	}})
+	router := newTestRouter(NewServer(client, "9999999999", nil, nil, nil))
+
+	var shipment openapi.CreateShipmentRequest
+	if err := json.Unmarshal([]byte(loadShipmentBody(t)), &shipment); err != nil {
+		t.Fatalf("could not decode the shipment fixture: %v", err)
+	}
+
+	sender := shipment.Shipment.SenderInformation.Address
+	sender.City = "MISSISSAUGA"
+	receiver := shipment.Shipment.ReceiverInformation.Address
+	receiver.City = "Vancouver"
+
+	body, _ := json.Marshal(openapi.AddressValidationRequest{Addresses: []openapi.Address{sender, receiver}})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/addresses:validate", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("ValidateAddresses() status = %v, want %v: %s", recorder.Code, http.StatusOK, recorder.Body.String())
+	}
+
+	var res openapi.AddressValidationRes
+	if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil || len(res.Results) != 2 {
+		t.Fatalf("ValidateAddresses() returned an invalid body: %s", recorder.Body.String())
+	}
+
+	if !res.Results[0].Valid || res.Results[0].Address.City != "Mississauga" {
+		t.Errorf("ValidateAddresses() sender = %+v, want it valid and normalized", res.Results[0])
+	}
+
+	errs := res.Results[1].Errors
+	if res.Results[1].Valid || len(errs) != 1 || stringValue(errs[0].Field) != "city" || stringValue(errs[0].Suggestion) != "Burnaby" {
+		t.Errorf("ValidateAddresses() receiver = %+v, want the city Burnaby suggested", res.Results[1])
+	}
+}
+
+func Test_CreateShipmentAddresses(t *testing.T) {
+	testCases := []struct {
+		name       string
+		change     func(body string) string
+		bodies     map[string]string
+		wantStatus int
+		wantField  string
+	}{
+		{
+			name: "When the postal code is malformed, return 400 before reaching Purolator",
+			change: func(body string) string {
+				return strings.Replace(body, `"V5C5A9"`, `"V5C"`, 1)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantField:  "shipment/receiverInformation/address/postalCode",
+		},
+		{
+			name:       "When Purolator rejects the city, return 422 with the suggestion",
+			change:     func(body string) string { return strings.Replace(body, `"Burnaby"`, `"Vancouver"`, 1) },
+			bodies:     map[string]string{"ValidateCityPostalCodeZip": cityMismatchXML},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantField:  "shipment/receiverInformation/address/city",
+		},
+		{
+			name:       "When the address check is not available, create the shipment anyway",
+			change:     func(body string) string { return body },
+			bodies:     map[string]string{"CreateShipment": shipmentCreatedXML},
+			wantStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := soap.NewSoapClient("key", "secret", &ActionHttpClient{bodies: tt.bodies})
+			router := newTestRouter(NewServer(client, "9999999999", nil, nil, nil))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(tt.change(loadShipmentBody(t))))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("CreateShipment() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			if len(tt.wantField) == 0 {
+				return
+			}
+
+			var res openapi.Error
+			if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil || res.Errors == nil || len(*res.Errors) == 0 {
+				t.Fatalf("CreateShipment() returned an invalid body: %s", recorder.Body.String())
+			}
+
+			if field := stringValue((*res.Errors)[0].Field); field != tt.wantField {
+				t.Errorf("CreateShipment() error field = %q, want %q", field, tt.wantField)
+			}
+		})
+	}
+}
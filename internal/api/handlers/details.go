@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/repository"
+)
+
+// GetShipmentDetails gathers the local record, the document URLs and the latest
+// tracking status of a shipment concurrently. The documents are required, the
+// record and the tracking are left out when they are not available.
+func (s *server) GetShipmentDetails(c *gin.Context, trackingNo string, params openapi.GetShipmentDetailsParams) {
+	const op string = "handlers.GetShipmentDetails"
+
+	if len(trackingNo) == 0 {
+		cErrors.JSON(c, op, "missing tracking number", nil, http.StatusBadRequest)
+		return
+	}
+
+	documentType := openapi.DomesticBillOfLading
+	if params.DocumentType != nil {
+		documentType = *params.DocumentType
+	}
+
+	ctx := c.Request.Context()
+	response := openapi.ShipmentDetailsRes{
+		TrackingNo: trackingNo,
+		Documents:  make([]openapi.Document, 0),
+	}
+
+	var (
+		wg          sync.WaitGroup
+		recordErr   error
+		documentErr error
+	)
+
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+		response.Record, recordErr = s.shipmentRecord(ctx, trackingNo)
+	}()
+
+	go func() {
+		defer wg.Done()
+		response.Documents, documentErr = s.documentURLs(ctx, trackingNo, documentType)
+	}()
+
+	go func() {
+		defer wg.Done()
+		response.Tracking = s.latestTracking(ctx, trackingNo)
+	}()
+
+	wg.Wait()
+
+	if documentErr != nil {
+		cErrors.JSON(c, op, "", documentErr, cErrors.Status(documentErr))
+		return
+	}
+
+	if recordErr != nil {
+		cErrors.JSON(c, op, "could not read the stored shipment", recordErr, http.StatusInternalServerError)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// shipmentRecord returns nil when the shipment was not created through this API.
+func (s *server) shipmentRecord(ctx context.Context, trackingNo string) (*openapi.ShipmentRecord, error) {
+	const op string = "handlers.shipmentRecord"
+
+	if s.shipments == nil {
+		return nil, nil
+	}
+
+	shipment, err := s.shipments.GetShipment(ctx, trackingNo)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return newShipmentRecord(*shipment)
+}
+
+func (s *server) documentURLs(ctx context.Context, trackingNo string, documentType openapi.DocumentType) ([]openapi.Document, error) {
+	data, err := s.client.GetDocuments(ctx, trackingNo, string(documentType), string(openapi.PDF))
+	if err != nil {
+		return nil, err
+	}
+
+	documents := make([]openapi.Document, 0)
+	for _, document := range data.Documents {
+		for _, detail := range document.DocumentDetails {
+			documents = append(documents, openapi.Document{
+				DocumentType:   detail.DocumentType,
+				DocumentStatus: detail.DocumentStatus,
+				Url:            optional(detail.URL),
+			})
+		}
+	}
+
+	return documents, nil
+}
+
+// latestTracking returns nil when the tracking is not available, a shipment
+// has no scans until it is picked up.
+func (s *server) latestTracking(ctx context.Context, trackingNo string) *openapi.Tracking {
+	const op string = "handlers.latestTracking"
+
+	data, err := s.client.TrackPackagesByPin(ctx, trackingNo)
+	if err != nil {
+		fmt.Printf("%s: %s\n", op, err)
+		return nil
+	}
+
+	if len(data.TrackingInformationList) == 0 {
+		return nil
+	}
+
+	tracking := newTracking(data.TrackingInformationList[0])
+	return &tracking
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/idempotency"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/repository"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+const documentsXML = `<s:Envelope>
+	<s:Body>
+		<GetDocumentsResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<Documents>
+				<Document>
+					<PIN><Value>329014521622</Value></PIN>
+					<DocumentDetails>
+						<DocumentDetail>
+							<DocumentType>DomesticBillOfLading</DocumentType>
+							<DocumentStatus>Completed</DocumentStatus>
+							<URL>https://eshiponline.purolator.com/document.pdf</URL>
+						</DocumentDetail>
+					</DocumentDetails>
+				</Document>
+			</Documents>
+		</GetDocumentsResponse>
+	</s:Body>
+</s:Envelope>`
+
+const trackingXML = `<s:Envelope>
+	<s:Body>
+		<TrackPackagesByPinResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<TrackingInformationList>
+				<TrackingInformation>
+					<PIN><Value>329014521622</Value></PIN>
+					<Scans>
+						<Scan>
+							<ScanType>Delivery</ScanType>
+							<ScanDate>2024-03-04</ScanDate>
+							<ScanTime>101500</ScanTime>
+							<Description>Shipment delivered to</Description>
+						</Scan>
+					</Scans>
+				</TrackingInformation>
+			</TrackingInformationList>
+		</TrackPackagesByPinResponse>
+	</s:Body>
+</s:Envelope>`
+
+func pinNotFoundXML(action string) string {
+	return `<s:Envelope>
+	<s:Body>
+		<` + action + `Response>
+			<ResponseInformation>
+				<Errors>
+					<Error>
+						<Code>1100546</Code>
+						<Description>PIN not found</Description>
+					</Error>
+				</Errors>
+			</ResponseInformation>
+		</` + action + `Response>
+	</s:Body>
+</s:Envelope>`
+}
+
+// ActionHttpClient answers each soap action with its own body, or with
+// a PIN not found error when the action is missing.
+type ActionHttpClient struct {
+	bodies map[string]string
+}
+
+func (c *ActionHttpClient) Do(req *http.Request) (*http.Response, error) {
+	soapAction := req.Header.Get("soapAction")
+	action := soapAction[strings.LastIndex(soapAction, "/")+1:]
+
+	body, ok := c.bodies[action]
+	if !ok {
+		body = pinNotFoundXML(action)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}, nil
+}
+
+func Test_GetShipmentDetails(t *testing.T) {
+	repo, err := repository.NewSQLiteRepository(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository() error = %v", err)
+	}
+	defer repo.Close()
+
+	err = repo.SaveShipment(context.Background(), repository.Shipment{
+		TrackingNo:       "329014521622",
+		PiecePINs:        []string{"329014521622", "329014521630"},
+		ReturnPINs:       []string{"329014521648"},
+		ExpressChequePIN: "329014521655",
+		Request:          json.RawMessage(loadShipmentBody(t)),
+		CreatedAt:        time.Now().UTC(),
+	})
+	if err != nil {
+		t.Fatalf("SaveShipment() error = %v", err)
+	}
+
+	testCases := []struct {
+		name         string
+		trackingNo   string
+		bodies       map[string]string
+		wantStatus   int
+		wantRecord   bool
+		wantTracking bool
+	}{
+		{
+			name:         "When everything is available, return it all",
+			trackingNo:   "329014521622",
+			bodies:       map[string]string{"GetDocuments": documentsXML, "TrackPackagesByPin": trackingXML},
+			wantStatus:   http.StatusOK,
+			wantRecord:   true,
+			wantTracking: true,
+		},
+		{
+			name:       "When there are no scans yet, leave the tracking out",
+			trackingNo: "329014521622",
+			bodies:     map[string]string{"GetDocuments": documentsXML},
+			wantStatus: http.StatusOK,
+			wantRecord: true,
+		},
+		{
+			name:         "When the shipment was not created here, leave the record out",
+			trackingNo:   "329014521999",
+			bodies:       map[string]string{"GetDocuments": documentsXML, "TrackPackagesByPin": trackingXML},
+			wantStatus:   http.StatusOK,
+			wantTracking: true,
+		},
+		{
+			name:       "When the PIN of the documents is not found, return 404",
+			trackingNo: "329014521622",
+			bodies:     map[string]string{"TrackPackagesByPin": trackingXML},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := soap.NewSoapClient("key", "secret", &ActionHttpClient{bodies: tt.bodies})
+			router := newTestRouter(NewServer(client, "9999999999", nil, idempotency.NewMemoryStore(idempotency.DefaultTTL), repo))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/shipments/"+tt.trackingNo+"/details", nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("GetShipmentDetails() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			if recorder.Code != http.StatusOK {
+				return
+			}
+
+			var res openapi.ShipmentDetailsRes
+			if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil {
+				t.Fatalf("GetShipmentDetails() returned an invalid body: %v", err)
+			}
+
+			if len(res.Documents) != 1 || res.Documents[0].Url == nil {
+				t.Errorf("GetShipmentDetails() documents = %+v, want one document with its URL", res.Documents)
+			}
+
+			if (res.Record != nil) != tt.wantRecord {
+				t.Fatalf("GetShipmentDetails() record = %+v, want record %v", res.Record, tt.wantRecord)
+			}
+
+			if res.Record != nil {
+				if len(res.Record.TrackingNOs) != 2 || res.Record.ReturnTrackingNOs == nil || res.Record.ExpressChequeNo == nil {
+					t.Errorf("GetShipmentDetails() record = %+v, want the piece, return and express cheque PINs", res.Record)
+				}
+			}
+
+			if (res.Tracking != nil) != tt.wantTracking {
+				t.Fatalf("GetShipmentDetails() tracking = %+v, want tracking %v", res.Tracking, tt.wantTracking)
+			}
+
+			if res.Tracking != nil && res.Tracking.Status != openapi.Delivered {
+				t.Errorf("GetShipmentDetails() tracking status = %v, want %v", res.Tracking.Status, openapi.Delivered)
+			}
+		})
+	}
+}
@@ -1,15 +1,85 @@
 package handlers
 
 import (
-	"encoding/xml"
+	"context"
+	"encoding/base64"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
 )
 
-const shipmentURL string = "https://devwebservices.purolator.com"
+func (s *server) GetDocument(c *gin.Context, trackingNo string, params openapi.GetDocumentParams) {
+	const op string = "handlers.GetDocument"
 
-func (s *server) GetDocument(c *gin.Context, trackingNO string) {
-	const op string = "hanlders.CreateShipment"
+	if len(trackingNo) == 0 {
+		cErrors.JSON(c, op, "missing tracking number", nil, http.StatusBadRequest)
+		return
+	}
 
-	xml.Marshal(op)
+	documentType := openapi.DomesticBillOfLading
+	if params.DocumentType != nil {
+		documentType = *params.DocumentType
+	}
+
+	format := openapi.PDF
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	output := openapi.Url
+	if params.Output != nil {
+		output = *params.Output
+	}
+
+	data, err := s.client.GetDocuments(c.Request.Context(), trackingNo, string(documentType), string(format))
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	response := openapi.GetDocumentRes{
+		TrackingNo: trackingNo,
+		Documents:  make([]openapi.Document, 0),
+	}
+
+	for _, document := range data.Documents {
+		for _, detail := range document.DocumentDetails {
+			doc := openapi.Document{
+				DocumentType:   detail.DocumentType,
+				DocumentStatus: detail.DocumentStatus,
+			}
+
+			if output != openapi.Data && len(detail.URL) > 0 {
+				url := detail.URL
+				doc.Url = &url
+			}
+
+			// pending documents have neither data nor URL yet, their status tells the caller
+			if output != openapi.Url && (len(detail.Data) > 0 || len(detail.URL) > 0) {
+				content, err := s.documentData(c.Request.Context(), detail)
+				if err != nil {
+					cErrors.JSON(c, op, "could not retrieve the document data", err, http.StatusBadGateway)
+					return
+				}
+				doc.Data = &content
+			}
+
+			response.Documents = append(response.Documents, doc)
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// documentData returns the raw document, decoding it when it came inline
+// on the response or downloading it from its URL otherwise.
+func (s *server) documentData(ctx context.Context, detail models.DocumentDetail) ([]byte, error) {
+	if len(detail.Data) > 0 {
+		return base64.StdEncoding.DecodeString(detail.Data)
+	}
+
+	return s.client.DownloadDocument(ctx, detail.URL)
 }
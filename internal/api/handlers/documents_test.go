@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+func documentXML(status, content string) string {
+	return `<s:Envelope>
+	<s:Body>
+		<GetDocumentsResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<Documents>
+				<Document>
+					<PIN><Value>329014521622</Value></PIN>
+					<DocumentDetails>
+						<DocumentDetail>
+							<DocumentType>DomesticBillOfLading</DocumentType>
+							<DocumentStatus>` + status + `</DocumentStatus>
+							` + content + `
+						</DocumentDetail>
+					</DocumentDetails>
+				</Document>
+			</Documents>
+		</GetDocumentsResponse>
+	</s:Body>
+</s:Envelope>`
+}
+
+func Test_GetDocument(t *testing.T) {
+	inline := base64.StdEncoding.EncodeToString([]byte("%PDF-1.4"))
+
+	testCases := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantData   string
+	}{
+		{
+			name:       "When the document came inline, return its data",
+			body:       documentXML("Completed", "<Data>"+inline+"</Data>"),
+			wantStatus: http.StatusOK,
+			wantData:   "%PDF-1.4",
+		},
+		{
+			name:       "When the document has no URL yet, return it without data",
+			body:       documentXML("Pending", ""),
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := soap.NewSoapClient("key", "secret", &ActionHttpClient{bodies: map[string]string{"GetDocuments": tt.body}})
+			router := newTestRouter(NewServer(client, "9999999999", nil, nil, nil))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/shipments/329014521622?output=data", nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("GetDocument() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			var res openapi.GetDocumentRes
+			if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil || len(res.Documents) != 1 {
+				t.Fatalf("GetDocument() returned an invalid body: %s", recorder.Body.String())
+			}
+
+			got := ""
+			if res.Documents[0].Data != nil {
+				got = string(*res.Documents[0].Data)
+			}
+
+			if got != tt.wantData {
+				t.Errorf("GetDocument() data = %q, want %q", got, tt.wantData)
+			}
+		})
+	}
+}
@@ -1,16 +1,36 @@
 package handlers
 
 import (
+	"sync"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/idempotency"
 	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/repository"
 	"github.com/pesimista/purolator-rest-api/internal/api/soap"
 )
 
 type server struct {
-	client *soap.SoapClient
+	client         *soap.SoapClient
+	billingAccount string
+	breakers       *soap.BreakerClient
+	idempotency    idempotency.Store
+	shipments      repository.Repository
+	// inFlight holds the idempotency keys of the shipments being created.
+	inFlight sync.Map
 }
 
-func NewServer(client *soap.SoapClient) openapi.ServerInterface {
+func NewServer(
+	client *soap.SoapClient,
+	billingAccount string,
+	breakers *soap.BreakerClient,
+	idempotencyStore idempotency.Store,
+	shipments repository.Repository,
+) openapi.ServerInterface {
 	return &server{
-		client: client,
+		client:         client,
+		billingAccount: billingAccount,
+		breakers:       breakers,
+		idempotency:    idempotencyStore,
+		shipments:      shipments,
 	}
 }
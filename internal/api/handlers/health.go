@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+// GetHealth reports the circuit of every Purolator service, the API itself is up if it answers.
+func (s *server) GetHealth(c *gin.Context) {
+	health := openapi.HealthRes{
+		Status:   openapi.Ok,
+		Services: make([]openapi.ServiceHealth, 0),
+	}
+
+	if s.breakers == nil {
+		c.JSON(http.StatusOK, health)
+		return
+	}
+
+	for _, status := range s.breakers.Health() {
+		service := openapi.ServiceHealth{
+			Service: status.Service,
+			State:   openapi.ServiceHealthState(status.State),
+		}
+
+		if status.State != soap.CircuitClosed {
+			health.Status = openapi.Degraded
+		}
+
+		if status.RetryAfter > 0 {
+			retryAfter := int32(math.Ceil(status.RetryAfter.Seconds()))
+			service.RetryAfter = &retryAfter
+		}
+
+		health.Services = append(health.Services, service)
+	}
+
+	c.JSON(http.StatusOK, health)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/idempotency"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+// createShipmentOnce creates the shipment only the first time the key is used, later requests
+// with the same key and body get the stored response. Failed requests are not stored,
+// so they can be retried with the same key.
+func (s *server) createShipmentOnce(c *gin.Context, key string, shipment *openapi.CreateShipmentRequest) {
+	const op string = "handlers.CreateShipment"
+
+	ctx := c.Request.Context()
+
+	hash, err := idempotency.Hash(shipment)
+	if err != nil {
+		cErrors.JSON(c, op, "could not hash the request body", err, http.StatusInternalServerError)
+		return
+	}
+
+	if _, running := s.inFlight.LoadOrStore(key, struct{}{}); running {
+		cErrors.JSON(c, op, "a request with the same Idempotency-Key is still running", nil, http.StatusConflict)
+		return
+	}
+	defer s.inFlight.Delete(key)
+
+	record, err := s.idempotency.Get(ctx, key)
+	switch {
+	case err == nil:
+		if record.RequestHash != hash {
+			cErrors.JSON(c, op, "the Idempotency-Key was already used with a different request body", nil, http.StatusUnprocessableEntity)
+			return
+		}
+
+		c.Header("Idempotent-Replayed", "true")
+		c.Data(record.StatusCode, gin.MIMEJSON+"; charset=utf-8", record.Response)
+		return
+	case !errors.Is(err, idempotency.ErrNotFound):
+		cErrors.JSON(c, op, "", err, http.StatusInternalServerError)
+		return
+	}
+
+	// The key is claimed now. The client may give up while the shipment is being created, so it
+	// is created and stored on a context that outlives the request, otherwise the shipment could
+	// exist at Purolator without a record and the retry would create it again. Each call to
+	// Purolator is still bounded by the timeout of its own service.
+	ctx = context.WithoutCancel(ctx)
+
+	response, err := s.createShipment(ctx, shipment)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, createShipmentStatus(err))
+		return
+	}
+
+	content, err := json.Marshal(response)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, http.StatusInternalServerError)
+		return
+	}
+
+	record = &idempotency.Record{
+		RequestHash: hash,
+		StatusCode:  http.StatusCreated,
+		Response:    content,
+	}
+
+	// The shipment exists already, so its response is returned even if it could not be stored.
+	if err := s.idempotency.Save(ctx, key, *record); err != nil {
+		fmt.Printf("%s: could not store the response: %s\n", op, err)
+	}
+
+	c.Data(http.StatusCreated, gin.MIMEJSON+"; charset=utf-8", content)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+const domesticCountry = "CA"
+
+var errMissingCustomsData = errors.New("missing customs information")
+
+// validateInternational requires the customs data Purolator needs for a shipment
+// leaving Canada, listing every missing field at once. Domestic shipments are not checked.
+func validateInternational(shipment *openapi.CreateShipmentRequest) error {
+	const op string = "handlers.validateInternational"
+
+	if strings.EqualFold(shipment.Shipment.ReceiverInformation.Address.Country, domesticCountry) {
+		return nil
+	}
+
+	info := shipment.Shipment.InternationalInformation
+	if info == nil {
+		return fmt.Errorf("%s: %w: internationalInformation", op, errMissingCustomsData)
+	}
+
+	missing := make([]string, 0)
+
+	if info.DutyInformation == nil {
+		missing = append(missing, "dutyInformation")
+	} else if info.DutyInformation.BillDutiesToParty == openapi.DutyInformationBillDutiesToPartyBuyer && info.BuyerInformation == nil {
+		missing = append(missing, "buyerInformation")
+	}
+
+	documentsOnly := info.DocumentsOnlyIndicator != nil && *info.DocumentsOnlyIndicator
+	if !documentsOnly {
+		if info.ContentDetails == nil || len(*info.ContentDetails) == 0 {
+			missing = append(missing, "contentDetails")
+		} else {
+			for i, content := range *info.ContentDetails {
+				missing = append(missing, missingContentFields(i, content)...)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s: %w: %s", op, errMissingCustomsData, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func missingContentFields(i int, content openapi.ContentDetail) []string {
+	missing := make([]string, 0)
+	field := func(name string) {
+		missing = append(missing, fmt.Sprintf("contentDetails[%d].%s", i, name))
+	}
+
+	if len(strings.TrimSpace(content.Description)) == 0 {
+		field("description")
+	}
+
+	if len(content.HarmonizedCode) == 0 {
+		field("harmonizedCode")
+	}
+
+	if len(content.CountryOfManufacture) == 0 {
+		field("countryOfManufacture")
+	}
+
+	if content.UnitValue <= 0 {
+		field("unitValue")
+	}
+
+	if content.Quantity <= 0 {
+		field("quantity")
+	}
+
+	return missing
+}
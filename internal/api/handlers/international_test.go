@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+func Test_validateInternational(t *testing.T) {
+	documentsOnly := true
+
+	content := openapi.ContentDetail{
+		Description:          "Books",
+		HarmonizedCode:       "490199",
+		CountryOfManufacture: "CA",
+		UnitValue:            25,
+		Quantity:             2,
+	}
+	duty := &openapi.DutyInformation{
+		BillDutiesToParty:    openapi.DutyInformationBillDutiesToPartyReceiver,
+		BusinessRelationship: openapi.NotRelated,
+		Currency:             openapi.USD,
+	}
+	buyerDuty := &openapi.DutyInformation{
+		BillDutiesToParty:    openapi.DutyInformationBillDutiesToPartyBuyer,
+		BusinessRelationship: openapi.NotRelated,
+		Currency:             openapi.USD,
+	}
+
+	newShipment := func(country string, info *openapi.InternationalInformation) *openapi.CreateShipmentRequest {
+		shipment := &openapi.CreateShipmentRequest{}
+		shipment.Shipment.ReceiverInformation.Address.Country = country
+		shipment.Shipment.InternationalInformation = info
+		return shipment
+	}
+
+	testCases := []struct {
+		name    string
+		args    *openapi.CreateShipmentRequest
+		wantErr error
+	}{
+		{
+			name:    "When the receiver is in Canada, do not require customs data",
+			args:    newShipment("CA", nil),
+			wantErr: nil,
+		},
+		{
+			name:    "When the receiver is abroad and the content is declared, pass",
+			args:    newShipment("US", &openapi.InternationalInformation{ContentDetails: &[]openapi.ContentDetail{content}, DutyInformation: duty}),
+			wantErr: nil,
+		},
+		{
+			name:    "When the shipment only carries documents, do not require content details",
+			args:    newShipment("US", &openapi.InternationalInformation{DocumentsOnlyIndicator: &documentsOnly, DutyInformation: duty}),
+			wantErr: nil,
+		},
+		{
+			name:    "When the receiver is abroad without customs data, fail",
+			args:    newShipment("US", nil),
+			wantErr: errMissingCustomsData,
+		},
+		{
+			name:    "When the content details are missing, fail",
+			args:    newShipment("US", &openapi.InternationalInformation{DutyInformation: duty}),
+			wantErr: errMissingCustomsData,
+		},
+		{
+			name:    "When a content detail is incomplete, fail",
+			args:    newShipment("US", &openapi.InternationalInformation{ContentDetails: &[]openapi.ContentDetail{{Description: "Books", Quantity: 1}}, DutyInformation: duty}),
+			wantErr: errMissingCustomsData,
+		},
+		{
+			name:    "When the duties are billed to a missing buyer, fail",
+			args:    newShipment("US", &openapi.InternationalInformation{ContentDetails: &[]openapi.ContentDetail{content}, DutyInformation: buyerDuty}),
+			wantErr: errMissingCustomsData,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateInternational(tt.args)
+
+			if !errors.Is(err, tt.wantErr) || (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("validateInternational() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
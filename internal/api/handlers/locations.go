@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+const (
+	defaultLocationsLimit = 10
+	// maxLocations is asked to Purolator when the locations are filtered here,
+	// so the filters do not leave the response shorter than the limit.
+	maxLocations = 50
+)
+
+// locationTypes maps the location types of the Locator service to the API ones,
+// the ones missing here are returned as unknown.
+var locationTypes = map[string]openapi.LocationType{
+	"ShippingCentre": openapi.ShippingCentre,
+	"Depot":          openapi.ShippingCentre,
+	"Agent":          openapi.RetailAgent,
+	"RetailAgent":    openapi.RetailAgent,
+	"Locker":         openapi.Locker,
+}
+
+// ListLocations finds the Purolator locations around a postal code, a city or a point.
+func (s *server) ListLocations(c *gin.Context, params openapi.ListLocationsParams) {
+	const op string = "handlers.ListLocations"
+
+	searches := 0
+	for _, set := range []bool{params.PostalCode != nil, params.City != nil, params.Latitude != nil || params.Longitude != nil} {
+		if set {
+			searches++
+		}
+	}
+
+	if searches != 1 {
+		cErrors.JSON(c, op, "search by exactly one of postalCode, city or latitude and longitude", nil, http.StatusBadRequest)
+		return
+	}
+
+	limit := defaultLocationsLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	options := models.LocationSearchOptions{MaxNumberofLocations: limit}
+	if params.Type != nil || (params.HoldForPickup != nil && *params.HoldForPickup) {
+		options.MaxNumberofLocations = maxLocations
+	}
+
+	if params.Radius != nil {
+		options.RadialDistanceInKM = *params.Radius
+	}
+
+	var (
+		data *models.GetLocationsResponse
+		err  error
+	)
+
+	ctx := c.Request.Context()
+
+	switch {
+	case params.PostalCode != nil:
+		address := normalizeAddress(openapi.Address{PostalCode: *params.PostalCode, Country: domesticCountry})
+		if !canadianPostalCode.MatchString(address.PostalCode) {
+			cErrors.JSON(c, op, fmt.Sprintf("invalid postal code %q", *params.PostalCode), nil, http.StatusBadRequest)
+			return
+		}
+
+		data, err = s.client.GetLocationsByPostalCode(ctx, address.PostalCode, options)
+	case params.City != nil:
+		if params.Province == nil {
+			cErrors.JSON(c, op, "province is required to search by city", nil, http.StatusBadRequest)
+			return
+		}
+
+		country := domesticCountry
+		if params.Country != nil {
+			country = *params.Country
+		}
+
+		address := normalizeAddress(openapi.Address{City: *params.City, Province: *params.Province, Country: country})
+		data, err = s.client.GetLocationsByCity(ctx, address.City, address.Province, address.Country, options)
+	default:
+		if params.Latitude == nil || params.Longitude == nil {
+			cErrors.JSON(c, op, "latitude and longitude are required together", nil, http.StatusBadRequest)
+			return
+		}
+
+		data, err = s.client.GetLocationsByCoordinates(ctx, *params.Latitude, *params.Longitude, options)
+	}
+
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	locations := make([]openapi.Location, 0, limit)
+	for _, location := range data.Locations {
+		if len(locations) == limit {
+			break
+		}
+
+		response := newLocation(location)
+		if params.Type != nil && response.Type != *params.Type {
+			continue
+		}
+
+		if params.HoldForPickup != nil && *params.HoldForPickup && !response.HoldForPickup {
+			continue
+		}
+
+		locations = append(locations, response)
+	}
+
+	c.JSON(http.StatusOK, openapi.LocationsRes{Locations: locations})
+}
+
+func newLocation(location models.Location) openapi.Location {
+	locationType, ok := locationTypes[location.LocationType]
+	if !ok {
+		locationType = openapi.Unknown
+	}
+
+	response := openapi.Location{
+		Id:   location.LocationID,
+		Name: location.LocationName,
+		Type: locationType,
+		Address: openapi.Address{
+			Name:         location.LocationName,
+			StreetNumber: location.Address.StreetNumber,
+			StreetName:   location.Address.StreetName,
+			Suite:        optional(location.Address.Suite),
+			City:         location.Address.City,
+			Province:     location.Address.Province,
+			Country:      location.Address.Country,
+			PostalCode:   location.Address.PostalCode,
+		},
+		Latitude:      &location.Latitude,
+		Longitude:     &location.Longitude,
+		Distance:      &location.RadialDistanceInKM,
+		HoldForPickup: location.HoldForPickup,
+		Hours:         make([]openapi.BusinessHours, 0, len(location.BusinessHours)),
+	}
+
+	response.Address.PhoneNumber.CountryCode = optional(location.Address.PhoneNumber.CountryCode)
+	response.Address.PhoneNumber.AreaCode = optional(location.Address.PhoneNumber.AreaCode)
+	response.Address.PhoneNumber.Phone = optional(location.Address.PhoneNumber.Phone)
+
+	for _, hours := range location.BusinessHours {
+		response.Hours = append(response.Hours, openapi.BusinessHours{
+			Day:   hours.DayOfWeek,
+			Open:  hours.OpenTime,
+			Close: hours.CloseTime,
+		})
+	}
+
+	return response
+}
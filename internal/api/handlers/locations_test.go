@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+func locationsXML(operation string) string {
+	return `<s:Envelope>
+	<s:Body>
+		<` + operation + `Response>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<Locations>
+				<Location>
+					<LocationId>BBY01</LocationId>
+					<LocationName>Purolator Burnaby</LocationName>
+					<LocationType>ShippingCentre</LocationType>
+					<Address>
+						<StreetNumber>2245</StreetNumber>
+						<StreetName>Douglas Road</StreetName>
+						<City>Burnaby</City>
+						<Province>BC</Province>
+						<Country>CA</Country>
+						<PostalCode>V5C5A9</PostalCode>
+						<PhoneNumber>
+							<CountryCode>1</CountryCode>
+							<AreaCode>888</AreaCode>
+							<Phone>7445286</Phone>
+						</PhoneNumber>
+					</Address>
+					<Latitude>49.2666</Latitude>
+					<Longitude>-123.0046</Longitude>
+					<RadialDistanceInKM>0.4</RadialDistanceInKM>
+					<HoldForPickup>true</HoldForPickup>
+					<BusinessHours>
+						<BusinessHour>
+							<DayOfWeek>Monday</DayOfWeek>
+							<OpenTime>08:00</OpenTime>
+							<CloseTime>18:00</CloseTime>
+						</BusinessHour>
+					</BusinessHours>
+				</Location>
+				<Location>
+					<LocationId>BBY02</LocationId>
+					<LocationName>Burnaby Locker</LocationName>
+					<LocationType>Locker</LocationType>
+					<Address>
+						<StreetNumber>4700</StreetNumber>
+						<StreetName>Kingsway</StreetName>
+						<City>Burnaby</City>
+						<Province>BC</Province>
+						<Country>CA</Country>
+						<PostalCode>V5H4M1</PostalCode>
+					</Address>
+					<Latitude>49.2276</Latitude>
+					<Longitude>-123.0004</Longitude>
+					<RadialDistanceInKM>4.3</RadialDistanceInKM>
+					<HoldForPickup>false</HoldForPickup>
+				</Location>
+			</Locations>
+		</` + operation + `Response>
+	</s:Body>
+</s:Envelope>`
+}
+
+func Test_ListLocations(t *testing.T) {
+	bodies := map[string]string{
+		"GetLocationsByCity":        locationsXML("GetLocationsByCity"),
+		"GetLocationsByPostalCode":  locationsXML("GetLocationsByPostalCode"),
+		"GetLocationsByCoordinates": locationsXML("GetLocationsByCoordinates"),
+	}
+
+	testCases := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantIDs    []string
+	}{
+		{
+			name:       "When searching by postal code, return every location",
+			query:      "?postalCode=v5c%205a9",
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{"BBY01", "BBY02"},
+		},
+		{
+			name:       "When searching by city for lockers, return only the lockers",
+			query:      "?city=burnaby&province=British%20Columbia&type=locker",
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{"BBY02"},
+		},
+		{
+			name:       "When searching by coordinates for hold for pickup, return only the eligible locations",
+			query:      "?latitude=49.2666&longitude=-123.0046&holdForPickup=true",
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{"BBY01"},
+		},
+		{
+			name:       "When the limit is lower than the locations found, cut the list",
+			query:      "?postalCode=V5C5A9&limit=1",
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{"BBY01"},
+		},
+		{
+			name:       "When searching by postal code and city at once, return 400",
+			query:      "?postalCode=V5C5A9&city=Burnaby&province=BC",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "When the city has no province, return 400",
+			query:      "?city=Burnaby",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "When the longitude is missing, return 400",
+			query:      "?latitude=49.2666",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "When the postal code is not Canadian, return 400",
+			query:      "?postalCode=12345",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := soap.NewSoapClient("key", "secret", &ActionHttpClient{bodies: bodies})
+			router := newTestRouter(NewServer(client, "9999999999", nil, nil, nil))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/locations"+tt.query, nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("ListLocations() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			if recorder.Code != http.StatusOK {
+				return
+			}
+
+			var res openapi.LocationsRes
+			if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil || len(res.Locations) != len(tt.wantIDs) {
+				t.Fatalf("ListLocations() returned %s, want %v", recorder.Body.String(), tt.wantIDs)
+			}
+
+			for i, location := range res.Locations {
+				if location.Id != tt.wantIDs[i] {
+					t.Errorf("ListLocations() location %d = %v, want %v", i, location.Id, tt.wantIDs[i])
+				}
+			}
+
+			if first := res.Locations[0]; first.Id == "BBY01" && (first.Type != openapi.ShippingCentre || len(first.Hours) != 1 || first.Address.PostalCode != "V5C5A9") {
+				t.Errorf("ListLocations() location = %+v, want the shipping centre with its hours", first)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+var errUnavailableOption = errors.New("option not available for the service")
+
+// checkOptions asks Purolator which options the service allows between the sender and
+// the receiver, and rejects the shipment when any of the requested ones is not allowed.
+// Shipments without options are not checked, neither are the ones with the auto service
+// because selectService only picks among the services allowing their options, so it must
+// run before the auto serviceID is replaced.
+func (s *server) checkOptions(ctx context.Context, shipment *openapi.CreateShipmentRequest) error {
+	const op string = "handlers.checkOptions"
+
+	request := soap.NewCreateShipmentRequest(shipment)
+	if request == nil || request.Shipment.PackageInformation.OptionsInformation == nil ||
+		request.Shipment.PackageInformation.ServiceID == autoService {
+		return nil
+	}
+
+	data, err := s.client.GetServicesOptions(
+		ctx,
+		s.billingAccount,
+		newShortAddress(request.Shipment.SenderInformation.Address),
+		newShortAddress(request.Shipment.ReceiverInformation.Address),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	serviceID := request.Shipment.PackageInformation.ServiceID
+	index := slices.IndexFunc(data.Services, func(service models.ServiceOptions) bool {
+		return service.ID == serviceID
+	})
+	if index < 0 {
+		return fmt.Errorf("%s: %w: %s is not available between the addresses", op, errUnavailableOption, serviceID)
+	}
+
+	problems := unavailableOptions(data.Services[index], request.Shipment.PackageInformation.OptionsInformation.Options)
+	if len(problems) > 0 {
+		return fmt.Errorf("%s: %w %s: %s", op, errUnavailableOption, serviceID, strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+// unavailableOptions lists the requested options, or option values, the service does not allow.
+func unavailableOptions(service models.ServiceOptions, options []models.OptionIDValuePair) []string {
+	available := make(map[string]models.ServiceOption)
+	indexOptions(available, service.Options)
+
+	problems := make([]string, 0)
+	for _, pair := range options {
+		option, ok := available[pair.ID]
+		if !ok {
+			problems = append(problems, pair.ID)
+			continue
+		}
+
+		if len(option.PossibleValues) > 0 && !slices.ContainsFunc(option.PossibleValues, func(value models.OptionValue) bool {
+			return value.Value == pair.Value
+		}) {
+			problems = append(problems, pair.ID+"="+pair.Value)
+		}
+	}
+
+	return problems
+}
+
+// indexOptions adds the options, and their child options, by ID.
+func indexOptions(index map[string]models.ServiceOption, options []models.ServiceOption) {
+	for _, option := range options {
+		index[option.ID] = option
+		indexOptions(index, option.ChildServiceOptions)
+	}
+}
+
+func newShortAddress(address models.Address) models.ShortAddress {
+	return models.ShortAddress{
+		City:       address.City,
+		Province:   address.Province,
+		Country:    address.Country,
+		PostalCode: address.PostalCode,
+	}
+}
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/idempotency"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+const servicesOptionsXML = `<s:Envelope>
+	<s:Body>
+		<GetServicesOptionsResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<Services>
+				<Service>
+					<ID>PurolatorExpress</ID>
+					<Options>
+						<Option>
+							<ID>ResidentialSignatureDomestic</ID>
+							<ValueType>Enumeration</ValueType>
+							<PossibleValues>
+								<OptionValue><Value>true</Value></OptionValue>
+							</PossibleValues>
+						</Option>
+						<Option>
+							<ID>DangerousGoods</ID>
+							<ValueType>Enumeration</ValueType>
+							<ChildServiceOptions>
+								<Option>
+									<ID>DangerousGoodsClass</ID>
+									<PossibleValues>
+										<OptionValue><Value>LimitedQuantities</Value></OptionValue>
+									</PossibleValues>
+								</Option>
+								<Option>
+									<ID>DangerousGoodsMode</ID>
+									<PossibleValues>
+										<OptionValue><Value>Ground</Value></OptionValue>
+									</PossibleValues>
+								</Option>
+							</ChildServiceOptions>
+						</Option>
+					</Options>
+				</Service>
+			</Services>
+		</GetServicesOptionsResponse>
+	</s:Body>
+</s:Envelope>`
+
+func Test_CreateShipmentOptions(t *testing.T) {
+	enabled := true
+
+	testCases := []struct {
+		name       string
+		serviceID  string
+		options    *openapi.ShipmentOptions
+		wantStatus int
+	}{
+		{
+			name:       "When there are no options, do not check them",
+			serviceID:  "PurolatorExpress",
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:      "When the options are available, create the shipment",
+			serviceID: "PurolatorExpress",
+			options: &openapi.ShipmentOptions{
+				ResidentialSignatureDomestic: &enabled,
+				DangerousGoods:               &openapi.DangerousGoodsOption{Class: openapi.LimitedQuantities, Mode: openapi.Ground},
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "When an option is not available, return 422",
+			serviceID:  "PurolatorExpress",
+			options:    &openapi.ShipmentOptions{SaturdayDelivery: &enabled},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:      "When an option value is not allowed, return 422",
+			serviceID: "PurolatorExpress",
+			options: &openapi.ShipmentOptions{
+				DangerousGoods: &openapi.DangerousGoodsOption{Class: openapi.FullyRegulated, Mode: openapi.Air},
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "When the service is not available, return 422",
+			serviceID:  "PurolatorGround",
+			options:    &openapi.ShipmentOptions{ResidentialSignatureDomestic: &enabled},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var shipment openapi.CreateShipmentRequest
+			if err := json.Unmarshal([]byte(loadShipmentBody(t)), &shipment); err != nil {
+				t.Fatalf("could not decode the shipment fixture: %v", err)
+			}
+			shipment.Shipment.PackageInformation.ServiceID = tt.serviceID
+			shipment.Shipment.PackageInformation.OptionsInformation = tt.options
+
+			body, _ := json.Marshal(shipment)
+
+			client := soap.NewSoapClient("key", "secret", &ActionHttpClient{bodies: map[string]string{
+				"GetServicesOptions": servicesOptionsXML,
+				"CreateShipment":     shipmentCreatedXML,
+			}})
+			router := newTestRouter(NewServer(client, "9999999999", nil, nil, nil))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(string(body)))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("CreateShipment() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func Test_CreateShipmentOptionsReplay(t *testing.T) {
+	enabled := true
+
+	var shipment openapi.CreateShipmentRequest
+	if err := json.Unmarshal([]byte(loadShipmentBody(t)), &shipment); err != nil {
+		t.Fatalf("could not decode the shipment fixture: %v", err)
+	}
+	shipment.Shipment.PackageInformation.OptionsInformation = &openapi.ShipmentOptions{ResidentialSignatureDomestic: &enabled}
+
+	body, _ := json.Marshal(shipment)
+
+	httpClient := &ActionHttpClient{bodies: map[string]string{
+		"GetServicesOptions": servicesOptionsXML,
+		"CreateShipment":     shipmentCreatedXML,
+	}}
+	client := soap.NewSoapClient("key", "secret", httpClient)
+	router := newTestRouter(NewServer(client, "9999999999", nil, idempotency.NewMemoryStore(idempotency.DefaultTTL), nil))
+
+	send := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", "order-1")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	if recorder := send(); recorder.Code != http.StatusCreated {
+		t.Fatalf("CreateShipment() status = %v, want %v: %s", recorder.Code, http.StatusCreated, recorder.Body.String())
+	}
+
+	// Purolator fails every call from now on, the replay must not need it
+	httpClient.bodies = nil
+
+	recorder := send()
+	if recorder.Code != http.StatusCreated || recorder.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("CreateShipment() replay = %v, want the stored response: %s", recorder.Code, recorder.Body.String())
+	}
+}
+
+// optionsCounter counts the GetServicesOptions calls answered by the wrapped client.
+type optionsCounter struct {
+	ActionHttpClient
+	calls int
+}
+
+func (c *optionsCounter) Do(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.Header.Get("soapAction"), "/GetServicesOptions") {
+		c.calls++
+	}
+	return c.ActionHttpClient.Do(req)
+}
+
+func Test_CreateShipmentAutoServiceOptions(t *testing.T) {
+	enabled := true
+
+	var shipment openapi.CreateShipmentRequest
+	if err := json.Unmarshal([]byte(loadShipmentBody(t)), &shipment); err != nil {
+		t.Fatalf("could not decode the shipment fixture: %v", err)
+	}
+	shipment.Shipment.PackageInformation.ServiceID = autoService
+	shipment.Shipment.PackageInformation.OptionsInformation = &openapi.ShipmentOptions{ResidentialSignatureDomestic: &enabled}
+	shipment.ServiceSelection = &openapi.ServiceSelection{Strategy: openapi.Cheapest}
+
+	body, _ := json.Marshal(shipment)
+
+	httpClient := &optionsCounter{ActionHttpClient: ActionHttpClient{bodies: map[string]string{
+		"GetFullEstimate":    fullEstimateXML,
+		"GetServicesOptions": servicesOptionsXML,
+		"CreateShipment":     shipmentCreatedXML,
+	}}}
+	client := soap.NewSoapClient("key", "secret", httpClient)
+	router := newTestRouter(NewServer(client, "9999999999", nil, nil, nil))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("CreateShipment() status = %v, want %v: %s", recorder.Code, http.StatusCreated, recorder.Body.String())
+	}
+
+	var res openapi.CreateShipmentRes
+	if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil || res.SelectedService == nil || res.SelectedService.ServiceID != "PurolatorExpress" {
+		t.Errorf("CreateShipment() selected service = %s, want PurolatorExpress, the only one allowing the options", recorder.Body.String())
+	}
+
+	if httpClient.calls != 1 {
+		t.Errorf("CreateShipment() asked for the options %d times, want 1", httpClient.calls)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+// weightTolerance is the precision Purolator takes, so totals rounded by the
+// caller still match the sum of the pieces.
+const weightTolerance = 0.1
+
+var errPackageMismatch = errors.New("package totals do not match the pieces")
+
+// validatePackage checks the totals of the package against its pieces, when they are given.
+func validatePackage(info openapi.PackageInformation) error {
+	const op string = "handlers.validatePackage"
+
+	if info.PiecesInformation == nil || len(info.PiecesInformation.Pieces) == 0 {
+		return nil
+	}
+
+	pieces := info.PiecesInformation.Pieces
+	if int(info.TotalPieces) != len(pieces) {
+		return fmt.Errorf("%s: %w: totalPieces is %d but there are %d pieces", op, errPackageMismatch, info.TotalPieces, len(pieces))
+	}
+
+	unit := string(info.TotalWeight.WeightUnit)
+
+	var sum float64
+	for _, piece := range pieces {
+		sum += soap.ConvertWeight(piece.Weight.Value, string(piece.Weight.WeightUnit), unit)
+	}
+
+	if math.Abs(sum-info.TotalWeight.Value) > weightTolerance+1e-9 {
+		return fmt.Errorf("%s: %w: totalWeight is %g %s but the pieces weigh %g %s", op, errPackageMismatch, info.TotalWeight.Value, unit, soap.RoundUp(sum), unit)
+	}
+
+	return nil
+}
+
+// validateShipmentPackages checks the package of the shipment and the one of its return labels.
+func validateShipmentPackages(shipment *openapi.CreateShipmentRequest) error {
+	if err := validatePackage(shipment.Shipment.PackageInformation); err != nil {
+		return err
+	}
+
+	if returns := shipment.Shipment.ReturnShipmentInformation; returns != nil {
+		return validatePackage(returns.ReturnShipment.PackageInformation)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+func Test_validatePackage(t *testing.T) {
+	piece := func(value float64, unit openapi.WeightWeightUnit) openapi.Piece {
+		return openapi.Piece{Weight: openapi.Weight{Value: value, WeightUnit: unit}}
+	}
+
+	newPackage := func(total float64, totalPieces int32, pieces ...openapi.Piece) openapi.PackageInformation {
+		info := openapi.PackageInformation{
+			TotalWeight: openapi.Weight{Value: total, WeightUnit: openapi.Lb},
+			TotalPieces: totalPieces,
+		}
+		if len(pieces) > 0 {
+			info.PiecesInformation = &struct {
+				Pieces []openapi.Piece `json:"pieces"`
+			}{Pieces: pieces}
+		}
+		return info
+	}
+
+	testCases := []struct {
+		name    string
+		args    openapi.PackageInformation
+		wantErr error
+	}{
+		{
+			name:    "When there are no pieces, only the totals are sent",
+			args:    newPackage(10, 3),
+			wantErr: nil,
+		},
+		{
+			name:    "When the pieces add up to the totals, pass",
+			args:    newPackage(10.5, 2, piece(5, openapi.Lb), piece(5.5, openapi.Lb)),
+			wantErr: nil,
+		},
+		{
+			name:    "When the pieces are in another unit, convert them before adding them",
+			args:    newPackage(4.4, 2, piece(1, openapi.Kg), piece(1, openapi.Kg)),
+			wantErr: nil,
+		},
+		{
+			name:    "When the piece count does not match, fail",
+			args:    newPackage(10, 3, piece(5, openapi.Lb), piece(5, openapi.Lb)),
+			wantErr: errPackageMismatch,
+		},
+		{
+			name:    "When the pieces do not add up to the total weight, fail",
+			args:    newPackage(12, 2, piece(5, openapi.Lb), piece(5, openapi.Lb)),
+			wantErr: errPackageMismatch,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validatePackage(tt.args); !errors.Is(err, tt.wantErr) {
+				t.Errorf("validatePackage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+func (s *server) SchedulePickup(c *gin.Context) {
+	const op string = "handlers.SchedulePickup"
+
+	var pickup *openapi.PickupRequest
+	if err := c.ShouldBindJSON(&pickup); err != nil {
+		cErrors.JSON(c, op, "could not bind request body", err, http.StatusBadRequest)
+		return
+	}
+
+	if _, err := s.client.ValidatePickUp(c.Request.Context(), pickup, s.billingAccount); err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	data, err := s.client.SchedulePickUp(c.Request.Context(), pickup, s.billingAccount)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, openapi.PickupRes{
+		ConfirmationNo: data.PickUpConfirmationNumber,
+	})
+}
+
+func (s *server) ModifyPickup(c *gin.Context, confirmationNo string) {
+	const op string = "handlers.ModifyPickup"
+
+	if len(confirmationNo) == 0 {
+		cErrors.JSON(c, op, "missing confirmation number", nil, http.StatusBadRequest)
+		return
+	}
+
+	var pickup *openapi.ModifyPickupRequest
+	if err := c.ShouldBindJSON(&pickup); err != nil {
+		cErrors.JSON(c, op, "could not bind request body", err, http.StatusBadRequest)
+		return
+	}
+
+	data, err := s.client.ModifyPickUp(c.Request.Context(), confirmationNo, pickup, s.billingAccount)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, openapi.PickupRes{
+		ConfirmationNo: data.PickUpConfirmationNumber,
+	})
+}
+
+func (s *server) VoidPickup(c *gin.Context, confirmationNo string) {
+	const op string = "handlers.VoidPickup"
+
+	if len(confirmationNo) == 0 {
+		cErrors.JSON(c, op, "missing confirmation number", nil, http.StatusBadRequest)
+		return
+	}
+
+	data, err := s.client.VoidPickUp(c.Request.Context(), confirmationNo)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	if !data.PickUpVoided {
+		cErrors.JSON(c, op, "the pickup could not be cancelled", nil, http.StatusConflict)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (s *server) GetPickupHistory(c *gin.Context, params openapi.GetPickupHistoryParams) {
+	const op string = "handlers.GetPickupHistory"
+
+	criteria := models.PickUpHistorySearchCriteria{
+		AccountNumber: s.billingAccount,
+	}
+
+	if params.ConfirmationNo != nil {
+		criteria.ConfirmationNumber = *params.ConfirmationNo
+	}
+
+	if params.From != nil {
+		criteria.FromDate = *params.From
+	}
+
+	if params.To != nil {
+		criteria.ToDate = *params.To
+	}
+
+	if params.Limit != nil {
+		criteria.MaxNumOfRecords = *params.Limit
+	}
+
+	data, err := s.client.GetPickUpHistory(c.Request.Context(), criteria)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	pickups := make([]openapi.Pickup, 0, len(data.PickUps))
+	for _, detail := range data.PickUps {
+		totalPieces := detail.TotalPieces
+
+		pickup := openapi.Pickup{
+			ConfirmationNo: detail.ConfirmationNumber,
+			Status:         detail.PickupStatus,
+			Date:           optional(detail.PickupDate),
+			AnyTimeAfter:   optional(detail.AnyTimeAfter),
+			UntilTime:      optional(detail.UntilTime),
+			TotalPieces:    &totalPieces,
+			PickUpLocation: optional(detail.PickUpLocation),
+		}
+
+		if len(detail.TotalWeight.WeightUnit) > 0 {
+			pickup.TotalWeight = &openapi.Weight{
+				Value:      float64(detail.TotalWeight.Value),
+				WeightUnit: openapi.WeightWeightUnit(detail.TotalWeight.WeightUnit),
+			}
+		}
+
+		pickups = append(pickups, pickup)
+	}
+
+	c.JSON(http.StatusOK, openapi.PickupHistoryRes{Pickups: pickups})
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+func (s *server) GetRates(c *gin.Context) {
+	const op string = "handlers.GetRates"
+
+	var rate *openapi.RateRequest
+	if err := c.ShouldBindJSON(&rate); err != nil {
+		cErrors.JSON(c, op, "could not bind request body", err, http.StatusBadRequest)
+		return
+	}
+
+	var (
+		data *models.GetEstimateResponse
+		err  error
+	)
+
+	switch rate.Type {
+	case openapi.Quick:
+		if rate.Quick == nil {
+			cErrors.JSON(c, op, "missing quick estimate information", nil, http.StatusBadRequest)
+			return
+		}
+		data, err = s.client.GetQuickEstimate(c.Request.Context(), rate.Quick, s.billingAccount)
+	case openapi.Full:
+		if rate.Full == nil {
+			cErrors.JSON(c, op, "missing full estimate information", nil, http.StatusBadRequest)
+			return
+		}
+		if err := validatePackage(rate.Full.PackageInformation); err != nil {
+			cErrors.JSON(c, op, "", err, http.StatusBadRequest)
+			return
+		}
+		data, err = s.client.GetFullEstimate(c.Request.Context(), rate.Full, s.billingAccount)
+	default:
+		cErrors.JSON(c, op, "invalid estimate type", nil, http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	estimates := make([]openapi.ServiceEstimate, 0, len(data.ShipmentEstimates))
+	for _, estimate := range data.ShipmentEstimates {
+		estimates = append(estimates, newServiceEstimate(estimate))
+	}
+
+	c.JSON(http.StatusOK, openapi.RatesRes{Estimates: estimates})
+}
+
+func newServiceEstimate(estimate models.ShipmentEstimate) openapi.ServiceEstimate {
+	serviceEstimate := openapi.ServiceEstimate{
+		ServiceID:            estimate.ServiceID,
+		ShipmentDate:         optional(estimate.ShipmentDate),
+		ExpectedDeliveryDate: optional(estimate.ExpectedDeliveryDate),
+		EstimatedTransitDays: &estimate.EstimatedTransitDays,
+		BasePrice:            estimate.BasePrice,
+		Surcharges:           make([]openapi.Charge, 0, len(estimate.Surcharges)),
+		Taxes:                make([]openapi.Charge, 0, len(estimate.Taxes)),
+		OptionPrices:         make([]openapi.Charge, 0, len(estimate.OptionPrices)),
+		TotalPrice:           estimate.TotalPrice,
+	}
+
+	for _, surcharge := range estimate.Surcharges {
+		serviceEstimate.Surcharges = append(serviceEstimate.Surcharges, newCharge(surcharge))
+	}
+
+	for _, tax := range estimate.Taxes {
+		serviceEstimate.Taxes = append(serviceEstimate.Taxes, newCharge(tax))
+	}
+
+	for _, option := range estimate.OptionPrices {
+		serviceEstimate.OptionPrices = append(serviceEstimate.OptionPrices, openapi.Charge{
+			Type:        option.ID,
+			Description: optional(option.Description),
+			Amount:      option.Amount,
+		})
+	}
+
+	return serviceEstimate
+}
+
+func newCharge(charge models.Charge) openapi.Charge {
+	return openapi.Charge{
+		Type:        charge.Type,
+		Description: optional(charge.Description),
+		Amount:      charge.Amount,
+	}
+}
+
+// optional returns nil for empty values so they are omitted from the response.
+func optional(value string) *string {
+	if len(value) == 0 {
+		return nil
+	}
+
+	return &value
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/repository"
+)
+
+const dateLayout = "2006-01-02"
+
+// ListShipments returns the shipments created through the API, read from the local store.
+func (s *server) ListShipments(c *gin.Context, params openapi.ListShipmentsParams) {
+	const op string = "handlers.ListShipments"
+
+	if s.shipments == nil {
+		cErrors.JSON(c, op, "the shipments are not stored", nil, http.StatusNotImplemented)
+		return
+	}
+
+	filter := repository.ShipmentFilter{}
+
+	if params.From != nil {
+		from, err := time.Parse(dateLayout, *params.From)
+		if err != nil {
+			cErrors.JSON(c, op, "invalid from date", err, http.StatusBadRequest)
+			return
+		}
+		filter.From = &from
+	}
+
+	if params.To != nil {
+		to, err := time.Parse(dateLayout, *params.To)
+		if err != nil {
+			cErrors.JSON(c, op, "invalid to date", err, http.StatusBadRequest)
+			return
+		}
+		// The whole day is included.
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	if params.Status != nil {
+		filter.Status = repository.Status(*params.Status)
+	}
+
+	if params.Reference != nil {
+		filter.Reference = *params.Reference
+	}
+
+	if params.PostalCode != nil {
+		filter.PostalCode = *params.PostalCode
+	}
+
+	if params.Cursor != nil {
+		filter.Cursor = *params.Cursor
+	}
+
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	page, err := s.shipments.ListShipments(c.Request.Context(), filter)
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		cErrors.JSON(c, op, "invalid cursor", err, http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		cErrors.JSON(c, op, "", err, http.StatusInternalServerError)
+		return
+	}
+
+	response := openapi.ShipmentListRes{
+		Shipments:  make([]openapi.ShipmentRecord, 0, len(page.Shipments)),
+		NextCursor: optional(page.NextCursor),
+	}
+
+	for _, shipment := range page.Shipments {
+		record, err := newShipmentRecord(shipment)
+		if err != nil {
+			cErrors.JSON(c, op, "", err, http.StatusInternalServerError)
+			return
+		}
+
+		response.Shipments = append(response.Shipments, *record)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func newShipmentRecord(shipment repository.Shipment) (*openapi.ShipmentRecord, error) {
+	const op string = "handlers.newShipmentRecord"
+
+	record := &openapi.ShipmentRecord{
+		TrackingNo:         shipment.TrackingNo,
+		TrackingNOs:        shipment.PiecePINs,
+		Status:             openapi.ShipmentStatus(shipment.Status),
+		Reference:          optional(shipment.Reference),
+		ReceiverPostalCode: optional(shipment.ReceiverPostalCode),
+		CreatedAt:          shipment.CreatedAt,
+		VoidedAt:           shipment.VoidedAt,
+		ExpressChequeNo:    optional(shipment.ExpressChequePIN),
+	}
+
+	if len(shipment.ReturnPINs) > 0 {
+		record.ReturnTrackingNOs = &shipment.ReturnPINs
+	}
+
+	if err := json.Unmarshal(shipment.Request, &record.Shipment); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return record, nil
+}
+
+// saveShipment keeps the created shipment on the local store. The shipment exists on
+// Purolator already, so a failure is only logged instead of failing the request, and the
+// record is saved even when the client disconnects meanwhile.
+func (s *server) saveShipment(ctx context.Context, shipment *openapi.CreateShipmentRequest, data *models.CreateShipmentResponse) {
+	const op string = "handlers.saveShipment"
+
+	if s.shipments == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.client.Timeouts().Shipping)
+	defer cancel()
+
+	request, err := json.Marshal(shipment)
+	if err != nil {
+		fmt.Printf("%s: %s\n", op, err)
+		return
+	}
+
+	record := repository.Shipment{
+		TrackingNo:         data.ShipmentPIN,
+		PiecePINs:          data.PiecePINs,
+		ReturnPINs:         data.ReturnShipmentPINs,
+		ExpressChequePIN:   data.ExpressChequePIN,
+		Status:             repository.StatusCreated,
+		ReceiverPostalCode: shipment.Shipment.ReceiverInformation.Address.PostalCode,
+		Request:            request,
+		CreatedAt:          time.Now().UTC(),
+	}
+
+	if info := shipment.Shipment.TrackingReferenceInformation; info != nil && info.Reference1 != nil {
+		record.Reference = *info.Reference1
+	}
+
+	if err := s.shipments.SaveShipment(ctx, record); err != nil {
+		fmt.Printf("%s: %s\n", op, err)
+	}
+}
+
+// voidShipment marks the stored shipment as voided, shipments created
+// somewhere else are not on the local store and are skipped.
+func (s *server) voidShipment(ctx context.Context, trackingNo string) {
+	const op string = "handlers.voidShipment"
+
+	if s.shipments == nil {
+		return
+	}
+
+	err := s.shipments.VoidShipment(ctx, trackingNo, time.Now().UTC())
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		fmt.Printf("%s: %s\n", op, err)
+	}
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+// autoService is the serviceID that lets the server pick the service of the shipment.
+const autoService = "auto"
+
+var (
+	errInvalidSelection = errors.New("invalid service selection")
+	errNoService        = errors.New("no service matches the selection")
+)
+
+// estimateServiceIDs is the service asked for when estimating an auto shipment by receiver
+// country, Purolator answers with the estimates of every other service on the route as well.
+var estimateServiceIDs = map[string]string{
+	domesticCountry: "PurolatorExpress",
+	unitedStates:    "PurolatorExpressU.S.",
+}
+
+const internationalServiceID = "PurolatorExpressInternational"
+
+// validateServiceSelection checks the shipments with the auto service say how to pick it.
+func validateServiceSelection(shipment *openapi.CreateShipmentRequest) error {
+	const op string = "handlers.validateServiceSelection"
+
+	if shipment.Shipment.PackageInformation.ServiceID != autoService {
+		return nil
+	}
+
+	selection := shipment.ServiceSelection
+	if selection == nil {
+		return fmt.Errorf("%s: %w: serviceSelection is required when the serviceID is %s", op, errInvalidSelection, autoService)
+	}
+
+	switch selection.Strategy {
+	case openapi.Cheapest, openapi.Fastest:
+		return nil
+	case openapi.DeliverBy:
+		if selection.DeliverBy == nil {
+			return fmt.Errorf("%s: %w: deliverBy is required by the %s strategy", op, errInvalidSelection, selection.Strategy)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%s: %w: unknown strategy %q", op, errInvalidSelection, selection.Strategy)
+	}
+}
+
+// selectService estimates every service on the route of an auto shipment, replaces the auto
+// serviceID with the one picked by the serviceSelection and returns its estimate. When the
+// shipment has options, only the services allowing them are considered. Shipments with
+// a serviceID are left as they are.
+func (s *server) selectService(ctx context.Context, shipment *openapi.CreateShipmentRequest) (*openapi.ServiceEstimate, error) {
+	const op string = "handlers.selectService"
+
+	packageInformation := &shipment.Shipment.PackageInformation
+	if packageInformation.ServiceID != autoService {
+		return nil, nil
+	}
+
+	if err := validateServiceSelection(shipment); err != nil {
+		return nil, err
+	}
+
+	rate := &openapi.FullRate{
+		SenderInformation:   shipment.Shipment.SenderInformation,
+		ReceiverInformation: shipment.Shipment.ReceiverInformation,
+		ShipmentDate:        &shipment.Shipment.ShipmentDate,
+		PackageInformation:  *packageInformation,
+	}
+
+	rate.PackageInformation.ServiceID = internationalServiceID
+	if serviceID, ok := estimateServiceIDs[shipment.Shipment.ReceiverInformation.Address.Country]; ok {
+		rate.PackageInformation.ServiceID = serviceID
+	}
+
+	data, err := s.client.GetFullEstimate(ctx, rate, s.billingAccount)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	estimates, err := s.estimatesWithOptions(ctx, shipment, data.ShipmentEstimates)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	estimate, ok := pickEstimate(estimates, *shipment.ServiceSelection)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w: %s", op, errNoService, shipment.ServiceSelection.Strategy)
+	}
+
+	packageInformation.ServiceID = estimate.ServiceID
+
+	selected := newServiceEstimate(estimate)
+	return &selected, nil
+}
+
+// estimatesWithOptions drops the estimates of the services that do not allow the options
+// of the shipment.
+func (s *server) estimatesWithOptions(ctx context.Context, shipment *openapi.CreateShipmentRequest, estimates []models.ShipmentEstimate) ([]models.ShipmentEstimate, error) {
+	request := soap.NewCreateShipmentRequest(shipment)
+	if request == nil || request.Shipment.PackageInformation.OptionsInformation == nil {
+		return estimates, nil
+	}
+
+	data, err := s.client.GetServicesOptions(
+		ctx,
+		s.billingAccount,
+		newShortAddress(request.Shipment.SenderInformation.Address),
+		newShortAddress(request.Shipment.ReceiverInformation.Address),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	options := request.Shipment.PackageInformation.OptionsInformation.Options
+
+	return slices.DeleteFunc(slices.Clone(estimates), func(estimate models.ShipmentEstimate) bool {
+		index := slices.IndexFunc(data.Services, func(service models.ServiceOptions) bool {
+			return service.ID == estimate.ServiceID
+		})
+
+		return index < 0 || len(unavailableOptions(data.Services[index], options)) > 0
+	}), nil
+}
+
+// pickEstimate returns the estimate that fits the strategy best. Cheapest and deliverBy
+// break the ties on the price by the delivery date, fastest breaks the ties on the
+// delivery date by the price.
+func pickEstimate(estimates []models.ShipmentEstimate, selection openapi.ServiceSelection) (models.ShipmentEstimate, bool) {
+	candidates := make([]models.ShipmentEstimate, 0, len(estimates))
+	for _, estimate := range estimates {
+		if selection.Strategy == openapi.DeliverBy &&
+			(len(estimate.ExpectedDeliveryDate) == 0 || estimate.ExpectedDeliveryDate > stringValue(selection.DeliverBy)) {
+			continue
+		}
+
+		candidates = append(candidates, estimate)
+	}
+
+	if len(candidates) == 0 {
+		return models.ShipmentEstimate{}, false
+	}
+
+	byPrice := func(a, b models.ShipmentEstimate) int {
+		return cmp.Compare(a.TotalPrice, b.TotalPrice)
+	}
+
+	byDelivery := func(a, b models.ShipmentEstimate) int {
+		return cmp.Or(cmp.Compare(deliveryDate(a), deliveryDate(b)), cmp.Compare(a.EstimatedTransitDays, b.EstimatedTransitDays))
+	}
+
+	compare := func(a, b models.ShipmentEstimate) int {
+		return cmp.Or(byPrice(a, b), byDelivery(a, b))
+	}
+
+	if selection.Strategy == openapi.Fastest {
+		compare = func(a, b models.ShipmentEstimate) int {
+			return cmp.Or(byDelivery(a, b), byPrice(a, b))
+		}
+	}
+
+	return slices.MinFunc(candidates, compare), true
+}
+
+// deliveryDate returns the expected delivery date of the estimate, the estimates without
+// one sort after every date.
+func deliveryDate(estimate models.ShipmentEstimate) string {
+	if len(estimate.ExpectedDeliveryDate) == 0 {
+		return "9999-12-31"
+	}
+
+	return estimate.ExpectedDeliveryDate
+}
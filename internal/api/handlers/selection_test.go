@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+const fullEstimateXML = `<s:Envelope>
+	<s:Body>
+		<GetFullEstimateResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<ShipmentEstimates>
+				<ShipmentEstimate>
+					<ServiceID>PurolatorExpress</ServiceID>
+					<ShipmentDate>2024-03-01</ShipmentDate>
+					<ExpectedDeliveryDate>2024-03-04</ExpectedDeliveryDate>
+					<EstimatedTransitDays>1</EstimatedTransitDays>
+					<BasePrice>40.5</BasePrice>
+					<TotalPrice>52.1</TotalPrice>
+				</ShipmentEstimate>
+				<ShipmentEstimate>
+					<ServiceID>PurolatorGround</ServiceID>
+					<ShipmentDate>2024-03-01</ShipmentDate>
+					<ExpectedDeliveryDate>2024-03-08</ExpectedDeliveryDate>
+					<EstimatedTransitDays>5</EstimatedTransitDays>
+					<BasePrice>20.5</BasePrice>
+					<TotalPrice>27.3</TotalPrice>
+				</ShipmentEstimate>
+			</ShipmentEstimates>
+		</GetFullEstimateResponse>
+	</s:Body>
+</s:Envelope>`
+
+func Test_pickEstimate(t *testing.T) {
+	deliverBy := func(value string) openapi.ServiceSelection {
+		return openapi.ServiceSelection{Strategy: openapi.DeliverBy, DeliverBy: &value}
+	}
+
+	estimates := []models.ShipmentEstimate{
+		{ServiceID: "PurolatorExpress", ExpectedDeliveryDate: "2024-03-04", EstimatedTransitDays: 1, TotalPrice: 52.1},
+		{ServiceID: "PurolatorExpress9AM", ExpectedDeliveryDate: "2024-03-04", EstimatedTransitDays: 1, TotalPrice: 80.4},
+		{ServiceID: "PurolatorGround", ExpectedDeliveryDate: "2024-03-08", EstimatedTransitDays: 5, TotalPrice: 27.3},
+		{ServiceID: "PurolatorGroundDistribution", TotalPrice: 27.3},
+	}
+
+	testCases := []struct {
+		name      string
+		selection openapi.ServiceSelection
+		want      string
+		wantOK    bool
+	}{
+		{
+			name:      "When looking for the cheapest, break the tie with the known delivery date",
+			selection: openapi.ServiceSelection{Strategy: openapi.Cheapest},
+			want:      "PurolatorGround",
+			wantOK:    true,
+		},
+		{
+			name:      "When looking for the fastest, break the tie with the price",
+			selection: openapi.ServiceSelection{Strategy: openapi.Fastest},
+			want:      "PurolatorExpress",
+			wantOK:    true,
+		},
+		{
+			name:      "When the ground service arrives in time, pick it",
+			selection: deliverBy("2024-03-08"),
+			want:      "PurolatorGround",
+			wantOK:    true,
+		},
+		{
+			name:      "When only the express services arrive in time, pick the cheapest of them",
+			selection: deliverBy("2024-03-05"),
+			want:      "PurolatorExpress",
+			wantOK:    true,
+		},
+		{
+			name:      "When no service arrives in time, pick none",
+			selection: deliverBy("2024-03-01"),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := pickEstimate(estimates, tt.selection)
+			if ok != tt.wantOK || got.ServiceID != tt.want {
+				t.Errorf("pickEstimate() = %v, %v, want %v, %v", got.ServiceID, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func Test_CreateShipmentAutoService(t *testing.T) {
+	auto := func(selection string) func(body string) string {
+		return func(body string) string {
+			body = strings.Replace(body, `"serviceID": "PurolatorExpress"`, `"serviceID": "auto"`, 1)
+			if len(selection) > 0 {
+				body = strings.Replace(body, `{`, `{"serviceSelection": `+selection+`,`, 1)
+			}
+			return body
+		}
+	}
+
+	bodies := map[string]string{"GetFullEstimate": fullEstimateXML, "CreateShipment": shipmentCreatedXML}
+
+	testCases := []struct {
+		name        string
+		change      func(body string) string
+		wantStatus  int
+		wantService string
+	}{
+		{
+			name:        "When looking for the cheapest service, create the shipment with it",
+			change:      auto(`{"strategy": "cheapest"}`),
+			wantStatus:  http.StatusCreated,
+			wantService: "PurolatorGround",
+		},
+		{
+			name:        "When looking for the fastest service, create the shipment with it",
+			change:      auto(`{"strategy": "fastest"}`),
+			wantStatus:  http.StatusCreated,
+			wantService: "PurolatorExpress",
+		},
+		{
+			name:       "When no service arrives in time, return 422",
+			change:     auto(`{"strategy": "deliverBy", "deliverBy": "2024-03-02"}`),
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "When the deliverBy date is missing, return 400",
+			change:     auto(`{"strategy": "deliverBy"}`),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "When the selection is missing, return 400",
+			change:     auto(""),
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := soap.NewSoapClient("key", "secret", &ActionHttpClient{bodies: bodies})
+			router := newTestRouter(NewServer(client, "9999999999", nil, nil, nil))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(tt.change(loadShipmentBody(t))))
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("CreateShipment() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			if recorder.Code != http.StatusCreated {
+				return
+			}
+
+			var res openapi.CreateShipmentRes
+			if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil || res.SelectedService == nil {
+				t.Fatalf("CreateShipment() returned an invalid body: %s", recorder.Body.String())
+			}
+
+			if res.SelectedService.ServiceID != tt.wantService {
+				t.Errorf("CreateShipment() selected service = %v, want %v", res.SelectedService.ServiceID, tt.wantService)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+// ListServices lists the services available between two postal codes with their options,
+// asking for the delivery dates at the same time. The options are required, the delivery
+// dates are left out when they are not available.
+func (s *server) ListServices(c *gin.Context, params openapi.ListServicesParams) {
+	const op string = "handlers.ListServices"
+
+	country := domesticCountry
+	if params.ToCountry != nil {
+		country = strings.ToUpper(*params.ToCountry)
+	}
+
+	sender := newPostalAddress(params.From, domesticCountry)
+	receiver := newPostalAddress(params.To, country)
+
+	if !canadianPostalCode.MatchString(sender.PostalCode) {
+		cErrors.JSON(c, op, fmt.Sprintf("invalid from postal code %q", params.From), nil, http.StatusBadRequest)
+		return
+	}
+
+	if (country == domesticCountry && !canadianPostalCode.MatchString(receiver.PostalCode)) ||
+		(country == unitedStates && !zipCode.MatchString(receiver.PostalCode)) {
+		cErrors.JSON(c, op, fmt.Sprintf("invalid to postal code %q", params.To), nil, http.StatusBadRequest)
+		return
+	}
+
+	shipmentDate := time.Now().Format(dateLayout)
+	if params.Date != nil {
+		shipmentDate = *params.Date
+	}
+
+	ctx := c.Request.Context()
+
+	var (
+		wg         sync.WaitGroup
+		data       *models.GetServicesOptionsResponse
+		optionsErr error
+		times      map[string]models.ServiceDeliveryTime
+	)
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		data, optionsErr = s.client.GetServicesOptions(ctx, s.billingAccount, sender, receiver)
+	}()
+
+	go func() {
+		defer wg.Done()
+		times = s.deliveryTimes(ctx, sender, receiver, shipmentDate)
+	}()
+
+	wg.Wait()
+
+	if optionsErr != nil {
+		cErrors.JSON(c, op, "", optionsErr, cErrors.Status(optionsErr))
+		return
+	}
+
+	services := make([]openapi.AvailableService, 0, len(data.Services))
+	for _, service := range data.Services {
+		services = append(services, newAvailableService(service, times[service.ID]))
+	}
+
+	c.JSON(http.StatusOK, openapi.ServicesRes{Services: services})
+}
+
+// deliveryTimes returns the delivery time of each service by ID, or none on error.
+func (s *server) deliveryTimes(ctx context.Context, sender, receiver models.ShortAddress, shipmentDate string) map[string]models.ServiceDeliveryTime {
+	const op string = "handlers.deliveryTimes"
+
+	times := make(map[string]models.ServiceDeliveryTime)
+
+	data, err := s.client.GetDeliveryTimes(ctx, sender, receiver, shipmentDate)
+	if err != nil {
+		fmt.Printf("%s: %s\n", op, err)
+		return times
+	}
+
+	for _, detail := range data.ShipmentDetails {
+		for _, service := range detail.ServiceDetails {
+			times[service.ServiceID] = service
+		}
+	}
+
+	return times
+}
+
+// newPostalAddress builds the short address of a postal code, the province of a
+// Canadian postal code comes from its first letter.
+func newPostalAddress(postalCode, country string) models.ShortAddress {
+	address := normalizeAddress(openapi.Address{PostalCode: postalCode, Country: country})
+
+	short := models.ShortAddress{
+		Country:    address.Country,
+		PostalCode: address.PostalCode,
+	}
+
+	if address.Country == domesticCountry && len(address.PostalCode) > 0 {
+		if provinces := postalProvinces[address.PostalCode[0]]; len(provinces) > 0 {
+			short.Province = provinces[0]
+		}
+	}
+
+	return short
+}
+
+func newAvailableService(service models.ServiceOptions, deliveryTime models.ServiceDeliveryTime) openapi.AvailableService {
+	return openapi.AvailableService{
+		ServiceID:              service.ID,
+		Description:            service.Description,
+		PackageType:            optional(service.PackageType),
+		PackageTypeDescription: optional(service.PackageTypeDescription),
+		DeliveryDate:           optional(deliveryTime.DeliveryDate),
+		DeliveryTime:           optional(deliveryTime.DeliveryTime),
+		Options:                newAvailableOptions(service.Options),
+	}
+}
+
+func newAvailableOptions(options []models.ServiceOption) []openapi.AvailableOption {
+	availableOptions := make([]openapi.AvailableOption, 0, len(options))
+	for _, option := range options {
+		availableForPieces := option.AvailableForPieces
+
+		availableOption := openapi.AvailableOption{
+			Id:                 option.ID,
+			Description:        option.Description,
+			ValueType:          optional(option.ValueType),
+			AvailableForPieces: &availableForPieces,
+			PossibleValues:     make([]openapi.AvailableOptionValue, 0, len(option.PossibleValues)),
+			ChildOptions:       newAvailableOptions(option.ChildServiceOptions),
+		}
+
+		for _, value := range option.PossibleValues {
+			availableOption.PossibleValues = append(availableOption.PossibleValues, openapi.AvailableOptionValue{
+				Value:       value.Value,
+				Description: optional(value.Description),
+			})
+		}
+
+		availableOptions = append(availableOptions, availableOption)
+	}
+
+	return availableOptions
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+const deliveryTimesXML = `<s:Envelope>
+	<s:Body>
+		<GetDeliveryTimesResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<ShipmentDetails>
+				<ShipmentDetail>
+					<ServiceDetails>
+						<ServiceDetail>
+							<ServiceID>PurolatorExpress</ServiceID>
+							<ServiceName>Purolator Express</ServiceName>
+							<DeliveryDate>2024-03-04</DeliveryDate>
+							<DeliveryTime>17:00</DeliveryTime>
+						</ServiceDetail>
+					</ServiceDetails>
+				</ShipmentDetail>
+			</ShipmentDetails>
+		</GetDeliveryTimesResponse>
+	</s:Body>
+</s:Envelope>`
+
+func Test_ListServices(t *testing.T) {
+	testCases := []struct {
+		name         string
+		query        string
+		bodies       map[string]string
+		wantStatus   int
+		wantDelivery bool
+	}{
+		{
+			name:         "When both services answer, list the options with the delivery date",
+			query:        "?from=L4W5M8&to=v5c%205a9&date=2024-03-01",
+			bodies:       map[string]string{"GetServicesOptions": servicesOptionsXML, "GetDeliveryTimes": deliveryTimesXML},
+			wantStatus:   http.StatusOK,
+			wantDelivery: true,
+		},
+		{
+			name:       "When the delivery times fail, list the options without them",
+			query:      "?from=L4W5M8&to=V5C5A9",
+			bodies:     map[string]string{"GetServicesOptions": servicesOptionsXML},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "When the options fail, return the status of the error",
+			query:      "?from=L4W5M8&to=V5C5A9",
+			bodies:     map[string]string{"GetDeliveryTimes": deliveryTimesXML},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "When the sender postal code is not Canadian, return 400",
+			query:      "?from=12345&to=V5C5A9",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := soap.NewSoapClient("key", "secret", &ActionHttpClient{bodies: tt.bodies})
+			router := newTestRouter(NewServer(client, "9999999999", nil, nil, nil))
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/services"+tt.query, nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("ListServices() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			if recorder.Code != http.StatusOK {
+				return
+			}
+
+			var res openapi.ServicesRes
+			if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil || len(res.Services) != 1 {
+				t.Fatalf("ListServices() returned an invalid body: %s", recorder.Body.String())
+			}
+
+			service := res.Services[0]
+			if service.ServiceID != "PurolatorExpress" || len(service.Options) != 2 || len(service.Options[1].ChildOptions) != 2 {
+				t.Errorf("ListServices() service = %+v, want PurolatorExpress with its options", service)
+			}
+
+			if (service.DeliveryDate != nil) != tt.wantDelivery {
+				t.Errorf("ListServices() delivery date = %v, want delivery date %v", service.DeliveryDate, tt.wantDelivery)
+			}
+		})
+	}
+}
@@ -1,56 +1,188 @@
 package handlers
 
 import (
-	"encoding/json"
-	"fmt"
-
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
 	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
 )
 
-const (
-	billingAccount string = "9999999999"
-)
-
-func (s *server) CreateShipment(c *gin.Context) {
+func (s *server) CreateShipment(c *gin.Context, params openapi.CreateShipmentParams) {
 	const op string = "hanlders.CreateShipment"
 
 	var shipment *openapi.CreateShipmentRequest
 	if err := c.ShouldBindJSON(&shipment); err != nil {
-		cErrors.JSON(c, op, "could not bind request body", err, http.StatusInternalServerError)
+		cErrors.JSON(c, op, "could not bind request body", err, http.StatusBadRequest)
 		return
 	}
 
-	account := billingAccount
+	account := s.billingAccount
 
 	shipment.Shipment.PaymentInformation.RegisteredAccountNumber = &account
 	shipment.Shipment.PaymentInformation.BillingAccountNumber = &account
 
-	data, err := s.client.CreateShipment(shipment)
+	// The return labels are prepaid by us as well.
+	if returns := shipment.Shipment.ReturnShipmentInformation; returns != nil {
+		returns.ReturnShipment.PaymentInformation.RegisteredAccountNumber = &account
+		returns.ReturnShipment.PaymentInformation.BillingAccountNumber = &account
+	}
+
+	if err := normalizeShipmentAddresses(shipment); err != nil {
+		cErrors.JSON(c, op, "", err, http.StatusBadRequest)
+		return
+	}
+
+	if err := validateInternational(shipment); err != nil {
+		cErrors.JSON(c, op, "", err, http.StatusBadRequest)
+		return
+	}
+
+	if err := validateShipmentPackages(shipment); err != nil {
+		cErrors.JSON(c, op, "", err, http.StatusBadRequest)
+		return
+	}
+
+	if err := validateServiceSelection(shipment); err != nil {
+		cErrors.JSON(c, op, "", err, http.StatusBadRequest)
+		return
+	}
+
+	if params.DryRun != nil && *params.DryRun {
+		s.validateShipment(c, shipment)
+		return
+	}
+
+	if params.IdempotencyKey != nil && len(*params.IdempotencyKey) > 0 && s.idempotency != nil {
+		s.createShipmentOnce(c, *params.IdempotencyKey, shipment)
+		return
+	}
+
+	response, err := s.createShipment(c.Request.Context(), shipment)
 	if err != nil {
-		cErrors.JSON(c, op, "", err, http.StatusInternalServerError)
+		cErrors.JSON(c, op, "", err, createShipmentStatus(err))
 		return
 	}
 
-	if data.Error != nil {
-		cErrors.JSON(c, op, data.Error.Description, err, http.StatusBadRequest)
+	c.JSON(http.StatusCreated, response)
+}
+
+// createShipment checks the cities of the addresses and the options of the service right
+// before creating the shipment, so replayed requests do not reach Purolator at all.
+func (s *server) createShipment(ctx context.Context, shipment *openapi.CreateShipmentRequest) (*openapi.CreateShipmentRes, error) {
+	if err := s.checkShipmentCities(ctx, shipment); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOptions(ctx, shipment); err != nil {
+		return nil, err
+	}
+
+	selected, err := s.selectService(ctx, shipment)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.client.CreateShipment(ctx, shipment)
+	if err != nil {
+		return nil, err
+	}
+
+	s.saveShipment(ctx, shipment, data)
+
+	response := newCreateShipmentRes(data)
+	response.SelectedService = selected
+
+	return response, nil
+}
+
+func createShipmentStatus(err error) int {
+	if errors.Is(err, errInvalidAddress) || errors.Is(err, errNoService) || errors.Is(err, errUnavailableOption) {
+		return http.StatusUnprocessableEntity
+	}
+
+	return cErrors.Status(err)
+}
+
+// newCreateShipmentRes maps the PINs of the created shipment, Purolator returns
+// the piece PINs in the same order as the pieces of the request.
+func newCreateShipmentRes(data *models.CreateShipmentResponse) *openapi.CreateShipmentRes {
+	response := &openapi.CreateShipmentRes{
+		MasterTrackingNo: data.ShipmentPIN,
+		TrackingNOs:      data.PiecePINs,
+		Pieces:           make([]openapi.PiecePIN, 0, len(data.PiecePINs)),
+		ExpressChequeNo:  optional(data.ExpressChequePIN),
+		Messages:         make([]openapi.InformationalMessage, 0, len(data.ResponseInformation.InformationalMessages)),
+	}
+
+	for i, pin := range data.PiecePINs {
+		response.Pieces = append(response.Pieces, openapi.PiecePIN{
+			PieceNo:    i + 1,
+			TrackingNo: pin,
+		})
+	}
+
+	if len(data.ReturnShipmentPINs) > 0 {
+		response.ReturnTrackingNOs = &data.ReturnShipmentPINs
+	}
+
+	for _, message := range data.ResponseInformation.InformationalMessages {
+		response.Messages = append(response.Messages, openapi.InformationalMessage{
+			Code:    message.Code,
+			Message: message.Message,
+		})
+	}
+
+	return response
+}
+
+// validateShipment runs the shipment through ValidateShipment, which never creates a PIN.
+func (s *server) validateShipment(c *gin.Context, shipment *openapi.CreateShipmentRequest) {
+	const op string = "handlers.ValidateShipment"
+
+	if err := s.checkOptions(c.Request.Context(), shipment); err != nil {
+		cErrors.JSON(c, op, "", err, createShipmentStatus(err))
+		return
+	}
+
+	selected, err := s.selectService(c.Request.Context(), shipment)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, createShipmentStatus(err))
+		return
+	}
+
+	data, err := s.client.ValidateShipment(c.Request.Context(), shipment)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
 		return
 	}
 
-	bytesaar, _ := json.MarshalIndent(data, "", "  ")
+	response := openapi.ValidateShipmentRes{
+		Valid:           data.ValidShipment,
+		Errors:          make([]openapi.ErrorDetail, 0, len(data.ResponseInformation.Errors)),
+		Messages:        make([]openapi.InformationalMessage, 0, len(data.ResponseInformation.InformationalMessages)),
+		SelectedService: selected,
+	}
 
-	fmt.Println(string(bytesaar))
+	for _, responseError := range data.ResponseInformation.Errors {
+		response.Errors = append(response.Errors, openapi.ErrorDetail{
+			Code:                  responseError.Code,
+			Description:           responseError.Description,
+			AdditionalInformation: optional(responseError.AdditionalInformation),
+		})
+	}
 
-	c.JSON(
-		http.StatusCreated,
-		openapi.CreateShipmentRes{
-			MasterTrackingNo: data.ShipmentPIN,
-			TrackingNOs:      data.PiecePINs,
-		},
-	)
+	for _, message := range data.ResponseInformation.InformationalMessages {
+		response.Messages = append(response.Messages, openapi.InformationalMessage{
+			Code:    message.Code,
+			Message: message.Message,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 func (s *server) VoidShipment(c *gin.Context, trackingNo string) {
@@ -61,11 +193,18 @@ func (s *server) VoidShipment(c *gin.Context, trackingNo string) {
 		return
 	}
 
-	_, err := s.client.VoidShipment(trackingNo)
+	data, err := s.client.VoidShipment(c.Request.Context(), trackingNo)
 	if err != nil {
-		cErrors.JSON(c, op, "", err, http.StatusBadRequest)
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
 		return
 	}
 
+	if !data.ShipmentVoided {
+		cErrors.JSON(c, op, "the shipment could not be voided", nil, http.StatusConflict)
+		return
+	}
+
+	s.voidShipment(c.Request.Context(), trackingNo)
+
 	c.Status(http.StatusNoContent)
 }
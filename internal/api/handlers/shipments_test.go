@@ -1 +1,371 @@
 package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pesimista/purolator-rest-api/internal/api/idempotency"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+	"github.com/pesimista/purolator-rest-api/internal/api/repository"
+	"github.com/pesimista/purolator-rest-api/internal/api/soap"
+)
+
+const shipmentCreatedXML = `<s:Envelope>
+	<s:Body>
+		<CreateShipmentResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<ShipmentPIN><Value>329014521622</Value></ShipmentPIN>
+			<PiecePINs>
+				<PIN><Value>329014521622</Value></PIN>
+			</PiecePINs>
+		</CreateShipmentResponse>
+	</s:Body>
+</s:Envelope>`
+
+// CountingHttpClient answers every request with the same body and counts the
+// requests of action, or all of them when action is empty.
+type CountingHttpClient struct {
+	body   string
+	action string
+	calls  int
+}
+
+func (c *CountingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	if len(c.action) == 0 || strings.HasSuffix(req.Header.Get("soapAction"), "/"+c.action) {
+		c.calls++
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader([]byte(c.body))),
+	}, nil
+}
+
+func loadShipmentBody(t *testing.T) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("..", "soap", "testdata", "create_shipment.json"))
+	if err != nil {
+		t.Fatalf("could not read the shipment fixture: %v", err)
+	}
+
+	return string(content)
+}
+
+func newTestRouter(si openapi.ServerInterface) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	openapi.RegisterHandlersWithOptions(router, si, openapi.GinServerOptions{BaseURL: "/api/v1"})
+
+	return router
+}
+
+func Test_CreateShipmentIdempotency(t *testing.T) {
+	body := loadShipmentBody(t)
+	otherBody := strings.Replace(body, `"Sender"`, `"Receiver"`, 1)
+	if otherBody == body {
+		t.Fatalf("the shipment fixture should have a Sender payment type")
+	}
+
+	httpClient := &CountingHttpClient{body: shipmentCreatedXML, action: "CreateShipment"}
+	client := soap.NewSoapClient("key", "secret", httpClient)
+	router := newTestRouter(NewServer(client, "9999999999", nil, idempotency.NewMemoryStore(idempotency.DefaultTTL), nil))
+
+	send := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if len(key) > 0 {
+			req.Header.Set("Idempotency-Key", key)
+		}
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	testCases := []struct {
+		name       string
+		key        string
+		body       string
+		wantStatus int
+		wantCalls  int
+		wantReplay bool
+	}{
+		{
+			name:       "When the key is new, create the shipment",
+			key:        "order-1",
+			body:       body,
+			wantStatus: http.StatusCreated,
+			wantCalls:  1,
+		},
+		{
+			name:       "When the key is replayed with the same body, return the stored response",
+			key:        "order-1",
+			body:       body,
+			wantStatus: http.StatusCreated,
+			wantCalls:  1,
+			wantReplay: true,
+		},
+		{
+			name:       "When the key is reused with another body, return 422",
+			key:        "order-1",
+			body:       otherBody,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCalls:  1,
+		},
+		{
+			name:       "When there is no key, always create the shipment",
+			body:       body,
+			wantStatus: http.StatusCreated,
+			wantCalls:  2,
+		},
+	}
+
+	var created string
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := send(tt.key, tt.body)
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("CreateShipment() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			if httpClient.calls != tt.wantCalls {
+				t.Errorf("CreateShipment() called Purolator %d times, want %d", httpClient.calls, tt.wantCalls)
+			}
+
+			replayed := recorder.Header().Get("Idempotent-Replayed") == "true"
+			if replayed != tt.wantReplay {
+				t.Errorf("CreateShipment() replayed = %v, want %v", replayed, tt.wantReplay)
+			}
+
+			if recorder.Code != http.StatusCreated {
+				return
+			}
+
+			if len(created) == 0 {
+				created = recorder.Body.String()
+			}
+
+			if recorder.Body.String() != created {
+				t.Errorf("CreateShipment() = %s, want %s", recorder.Body.String(), created)
+			}
+		})
+	}
+}
+
+// BlockingHttpClient holds the CreateShipment requests until release is closed, and fails
+// them like a real client would when their context is cancelled by then.
+type BlockingHttpClient struct {
+	started chan struct{}
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (c *BlockingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	soapAction := req.Header.Get("soapAction")
+	body := pinNotFoundXML(soapAction[strings.LastIndex(soapAction, "/")+1:])
+	if strings.HasSuffix(req.Header.Get("soapAction"), "/CreateShipment") {
+		c.calls.Add(1)
+		c.started <- struct{}{}
+		<-c.release
+		body = shipmentCreatedXML
+	}
+
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+	}, nil
+}
+
+func Test_CreateShipmentIdempotencyCancelled(t *testing.T) {
+	httpClient := &BlockingHttpClient{started: make(chan struct{}, 1), release: make(chan struct{})}
+	client := soap.NewSoapClient("key", "secret", httpClient)
+	router := newTestRouter(NewServer(client, "9999999999", nil, idempotency.NewMemoryStore(idempotency.DefaultTTL), nil))
+
+	send := func(ctx context.Context) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(loadShipmentBody(t))).WithContext(ctx)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", "order-1")
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		send(ctx)
+	}()
+
+	// the client gives up while Purolator is creating the shipment
+	<-httpClient.started
+	cancel()
+	close(httpClient.release)
+	<-done
+
+	recorder := send(context.Background())
+
+	if recorder.Code != http.StatusCreated || recorder.Header().Get("Idempotent-Replayed") != "true" {
+		t.Fatalf("CreateShipment() retry = %v replayed %q, want the stored response: %s",
+			recorder.Code, recorder.Header().Get("Idempotent-Replayed"), recorder.Body.String())
+	}
+
+	if calls := httpClient.calls.Load(); calls != 1 {
+		t.Errorf("CreateShipment() called Purolator %d times, want 1", calls)
+	}
+}
+
+func Test_ListShipments(t *testing.T) {
+	repo, err := repository.NewSQLiteRepository(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository() error = %v", err)
+	}
+	defer repo.Close()
+
+	client := soap.NewSoapClient("key", "secret", &CountingHttpClient{body: shipmentCreatedXML})
+	router := newTestRouter(NewServer(client, "9999999999", nil, idempotency.NewMemoryStore(idempotency.DefaultTTL), repo))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(loadShipmentBody(t)))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("CreateShipment() status = %v, want %v: %s", recorder.Code, http.StatusCreated, recorder.Body.String())
+	}
+
+	testCases := []struct {
+		name       string
+		query      string
+		wantStatus int
+		want       []string
+	}{
+		{
+			name:       "When there are no filters, list the created shipment",
+			wantStatus: http.StatusOK,
+			want:       []string{"329014521622"},
+		},
+		{
+			name:       "When the status does not match, list nothing",
+			query:      "?status=voided",
+			wantStatus: http.StatusOK,
+			want:       []string{},
+		},
+		{
+			name:       "When the date is invalid, return 400",
+			query:      "?from=yesterday",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "When the cursor is invalid, return 400",
+			query:      "?cursor=invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/shipments"+tt.query, nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("ListShipments() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			if recorder.Code != http.StatusOK {
+				return
+			}
+
+			var res openapi.ShipmentListRes
+			if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil {
+				t.Fatalf("ListShipments() returned an invalid body: %v", err)
+			}
+
+			got := make([]string, 0, len(res.Shipments))
+			for _, shipment := range res.Shipments {
+				got = append(got, shipment.TrackingNo)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListShipments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newCreateShipmentRes(t *testing.T) {
+	data := &models.CreateShipmentResponse{
+		ShipmentPIN:        "329014521622",
+		PiecePINs:          []string{"329014521622", "329014521630"},
+		ReturnShipmentPINs: []string{"329014521648"},
+		ExpressChequePIN:   "329014521655",
+	}
+	data.ResponseInformation.InformationalMessages = []models.InformationalMessage{
+		{Code: "1100988", Message: "Residential delivery"},
+	}
+
+	returns := []string{"329014521648"}
+	expressCheque := "329014521655"
+
+	want := &openapi.CreateShipmentRes{
+		MasterTrackingNo: "329014521622",
+		TrackingNOs:      []string{"329014521622", "329014521630"},
+		Pieces: []openapi.PiecePIN{
+			{PieceNo: 1, TrackingNo: "329014521622"},
+			{PieceNo: 2, TrackingNo: "329014521630"},
+		},
+		ReturnTrackingNOs: &returns,
+		ExpressChequeNo:   &expressCheque,
+		Messages:          []openapi.InformationalMessage{{Code: "1100988", Message: "Residential delivery"}},
+	}
+
+	if got := newCreateShipmentRes(data); !reflect.DeepEqual(got, want) {
+		t.Errorf("newCreateShipmentRes() = %+v, want %+v", got, want)
+	}
+}
+
+func Test_saveShipmentCancelled(t *testing.T) {
+	repo, err := repository.NewSQLiteRepository(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository() error = %v", err)
+	}
+	defer repo.Close()
+
+	var shipment openapi.CreateShipmentRequest
+	if err := json.Unmarshal([]byte(loadShipmentBody(t)), &shipment); err != nil {
+		t.Fatalf("could not decode the shipment fixture: %v", err)
+	}
+
+	s := &server{client: soap.NewSoapClient("key", "secret", nil), shipments: repo}
+
+	// the client disconnected after Purolator created the shipment
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.saveShipment(ctx, &shipment, &models.CreateShipmentResponse{ShipmentPIN: "329014521622", PiecePINs: []string{"329014521622"}})
+
+	if _, err := repo.GetShipment(context.Background(), "329014521622"); err != nil {
+		t.Errorf("GetShipment() error = %v, want the shipment saved", err)
+	}
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cErrors "github.com/pesimista/purolator-rest-api/internal/api/errors"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+const (
+	scanDateTimeLayout   = "2006-01-02150405"
+	eventTimestampLayout = "2006-01-02T15:04:05"
+)
+
+var scanEventTypes = map[string]openapi.TrackingEventEventType{
+	"ProofOfPickUp": openapi.PickUp,
+	"PickUp":        openapi.PickUp,
+	"Transit":       openapi.Transit,
+	"OnDelivery":    openapi.Transit,
+	"Delivery":      openapi.Delivery,
+	"Undeliverable": openapi.Undeliverable,
+}
+
+func (s *server) TrackShipment(c *gin.Context, trackingNo string) {
+	const op string = "handlers.TrackShipment"
+
+	if len(trackingNo) == 0 {
+		cErrors.JSON(c, op, "missing tracking number", nil, http.StatusBadRequest)
+		return
+	}
+
+	data, err := s.client.TrackPackagesByPin(c.Request.Context(), trackingNo)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	if len(data.TrackingInformationList) == 0 {
+		cErrors.JSON(c, op, "shipment not found", nil, http.StatusNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, newTracking(data.TrackingInformationList[0]))
+}
+
+func (s *server) TrackByReference(c *gin.Context, params openapi.TrackByReferenceParams) {
+	const op string = "handlers.TrackByReference"
+
+	if len(params.Reference) == 0 {
+		cErrors.JSON(c, op, "missing reference", nil, http.StatusBadRequest)
+		return
+	}
+
+	criteria := models.TrackPackageByReferenceSearchCriteria{
+		Reference:            params.Reference,
+		BillingAccountNumber: s.billingAccount,
+	}
+
+	if params.From != nil {
+		criteria.ShipmentFromDate = *params.From
+	}
+
+	if params.To != nil {
+		criteria.ShipmentToDate = *params.To
+	}
+
+	data, err := s.client.TrackPackagesByReference(c.Request.Context(), criteria)
+	if err != nil {
+		cErrors.JSON(c, op, "", err, cErrors.Status(err))
+		return
+	}
+
+	shipments := make([]openapi.Tracking, 0, len(data.TrackingInformationList))
+	for _, info := range data.TrackingInformationList {
+		shipments = append(shipments, newTracking(info))
+	}
+
+	c.JSON(http.StatusOK, openapi.TrackingListRes{Shipments: shipments})
+}
+
+// newTracking normalizes the scans of a package into a timeline, oldest
+// event first, and derives the status of the package from it.
+func newTracking(info models.TrackingInformation) openapi.Tracking {
+	events := make([]openapi.TrackingEvent, 0, len(info.Scans))
+	for _, scan := range info.Scans {
+		events = append(events, newTrackingEvent(scan))
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+
+	return openapi.Tracking{
+		TrackingNo: info.TrackingNo,
+		Status:     trackingStatus(events),
+		Events:     events,
+	}
+}
+
+func newTrackingEvent(scan models.Scan) openapi.TrackingEvent {
+	timestamp := scan.ScanDate
+	if scannedAt, err := time.Parse(scanDateTimeLayout, scan.ScanDate+scan.ScanTime); err == nil {
+		timestamp = scannedAt.Format(eventTimestampLayout)
+	}
+
+	eventType, ok := scanEventTypes[scan.ScanType]
+	if !ok {
+		eventType = openapi.Other
+	}
+
+	return openapi.TrackingEvent{
+		Timestamp:   timestamp,
+		Depot:       optional(scan.Depot),
+		Description: scan.Description,
+		Comment:     optional(scan.Comment),
+		EventType:   eventType,
+	}
+}
+
+// trackingStatus expects the events sorted from the oldest to the newest one.
+func trackingStatus(events []openapi.TrackingEvent) openapi.TrackingStatus {
+	for _, event := range events {
+		if event.EventType == openapi.Delivery {
+			return openapi.Delivered
+		}
+	}
+
+	if len(events) > 0 && events[len(events)-1].EventType == openapi.Undeliverable {
+		return openapi.Exception
+	}
+
+	return openapi.InTransit
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+func Test_newTracking(t *testing.T) {
+	depot := "VANCOUVER"
+
+	pickUp := models.Scan{ScanType: "ProofOfPickUp", Depot: depot, ScanDate: "2024-03-01", ScanTime: "153000", Description: "Picked up"}
+	transit := models.Scan{ScanType: "Other", Depot: depot, ScanDate: "2024-03-02", ScanTime: "080000", Description: "Arrived at sort facility"}
+	undeliverable := models.Scan{ScanType: "Undeliverable", Depot: depot, ScanDate: "2024-03-03", ScanTime: "120000", Description: "Receiver not available"}
+	delivery := models.Scan{ScanType: "Delivery", Depot: depot, ScanDate: "2024-03-04", ScanTime: "101500", Description: "Shipment delivered"}
+
+	testCases := []struct {
+		name       string
+		scans      []models.Scan
+		wantStatus openapi.TrackingStatus
+		wantEvents []openapi.TrackingEvent
+	}{
+		{
+			name:       "When the package was delivered, return delivered with the events sorted",
+			scans:      []models.Scan{delivery, undeliverable, transit, pickUp},
+			wantStatus: openapi.Delivered,
+			wantEvents: []openapi.TrackingEvent{
+				{Timestamp: "2024-03-01T15:30:00", Depot: &depot, Description: "Picked up", EventType: openapi.PickUp},
+				{Timestamp: "2024-03-02T08:00:00", Depot: &depot, Description: "Arrived at sort facility", EventType: openapi.Other},
+				{Timestamp: "2024-03-03T12:00:00", Depot: &depot, Description: "Receiver not available", EventType: openapi.Undeliverable},
+				{Timestamp: "2024-03-04T10:15:00", Depot: &depot, Description: "Shipment delivered", EventType: openapi.Delivery},
+			},
+		},
+		{
+			name:       "When the last scan is undeliverable, return exception",
+			scans:      []models.Scan{undeliverable, pickUp},
+			wantStatus: openapi.Exception,
+			wantEvents: []openapi.TrackingEvent{
+				{Timestamp: "2024-03-01T15:30:00", Depot: &depot, Description: "Picked up", EventType: openapi.PickUp},
+				{Timestamp: "2024-03-03T12:00:00", Depot: &depot, Description: "Receiver not available", EventType: openapi.Undeliverable},
+			},
+		},
+		{
+			name:       "When there are no scans, return in transit",
+			scans:      nil,
+			wantStatus: openapi.InTransit,
+			wantEvents: []openapi.TrackingEvent{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newTracking(models.TrackingInformation{TrackingNo: "329014521622", Scans: tt.scans})
+
+			if got.Status != tt.wantStatus {
+				t.Fatalf("handlers.newTracking() status = %v, want %v", got.Status, tt.wantStatus)
+			}
+
+			if !reflect.DeepEqual(got.Events, tt.wantEvents) {
+				t.Fatalf("handlers.newTracking() events = %+v, want %+v", got.Events, tt.wantEvents)
+			}
+		})
+	}
+}
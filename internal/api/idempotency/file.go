@@ -0,0 +1,140 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileStore keeps every record as a JSON file on a directory, so they survive a restart.
+type FileStore struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewFileStore creates the directory if it does not exist yet.
+func NewFileStore(dir string, ttl time.Duration) (*FileStore, error) {
+	const op string = "idempotency.NewFileStore"
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidStore, err)
+	}
+
+	return &FileStore{
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}, nil
+}
+
+func (s *FileStore) Get(ctx context.Context, key string) (*Record, error) {
+	const op string = "idempotency.FileStore.Get"
+
+	content, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidStore, err)
+	}
+
+	var record Record
+	if err := json.Unmarshal(content, &record); err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidStore, err)
+	}
+
+	if record.expired(s.now(), s.ttl) {
+		_ = os.Remove(s.path(key))
+		return nil, ErrNotFound
+	}
+
+	return &record, nil
+}
+
+// Save writes the record to a temporary file first, so a crash never leaves half a record behind.
+// It also removes the expired records every sweepInterval, so the directory does not grow with
+// keys that are never read again.
+func (s *FileStore) Save(ctx context.Context, key string, record Record) error {
+	const op string = "idempotency.FileStore.Save"
+
+	now := s.now()
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = now
+	}
+
+	s.mu.Lock()
+	sweep := sweepDue(&s.lastSweep, now, s.ttl)
+	s.mu.Unlock()
+
+	if sweep {
+		s.sweep(now)
+	}
+
+	content, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	file, err := os.CreateTemp(s.dir, "record-*.tmp")
+	if err != nil {
+		return fmt.Errorf("%s: %w %w", op, ErrInvalidStore, err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return fmt.Errorf("%s: %w %w", op, ErrInvalidStore, err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("%s: %w %w", op, ErrInvalidStore, err)
+	}
+
+	if err := os.Rename(file.Name(), s.path(key)); err != nil {
+		return fmt.Errorf("%s: %w %w", op, ErrInvalidStore, err)
+	}
+
+	return nil
+}
+
+// sweep removes the expired records, the ones that cannot be read are left for Get to report.
+func (s *FileStore) sweep(now time.Time) {
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.json"))
+	if err != nil {
+		return
+	}
+
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(content, &record); err != nil {
+			continue
+		}
+
+		if record.expired(now, s.ttl) {
+			_ = os.Remove(path)
+		}
+	}
+}
+
+// path hashes the key, which comes from the client, to get a safe file name.
+func (s *FileStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,67 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrNotFound     = errors.New("idempotency key not found")
+	ErrInvalidStore = errors.New("could not access the idempotency store")
+)
+
+// DefaultTTL is how long a stored response can be replayed.
+const DefaultTTL = 24 * time.Hour
+
+// sweepInterval is how often Save removes the expired records of the other keys,
+// Get only removes the record of the key it reads.
+const sweepInterval = time.Hour
+
+// Record is the response stored for an idempotency key,
+// along with the hash of the request that produced it.
+type Record struct {
+	RequestHash string          `json:"requestHash"`
+	StatusCode  int             `json:"statusCode"`
+	Response    json.RawMessage `json:"response"`
+	CreatedAt   time.Time       `json:"createdAt"`
+}
+
+func (r Record) expired(now time.Time, ttl time.Duration) bool {
+	return ttl > 0 && now.Sub(r.CreatedAt) > ttl
+}
+
+// sweepDue tells whether the expired records should be removed now, and moves lastSweep when they should.
+func sweepDue(lastSweep *time.Time, now time.Time, ttl time.Duration) bool {
+	if ttl <= 0 || now.Sub(*lastSweep) < sweepInterval {
+		return false
+	}
+
+	*lastSweep = now
+	return true
+}
+
+// Store keeps the records by idempotency key, Get returns ErrNotFound
+// for unknown or expired keys.
+type Store interface {
+	Get(ctx context.Context, key string) (*Record, error)
+	Save(ctx context.Context, key string, record Record) error
+}
+
+// Hash returns the hash identifying a request body, it is computed from the
+// JSON encoding of the value so whitespace and key order do not matter.
+func Hash(value any) (string, error) {
+	const op string = "idempotency.Hash"
+
+	content, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
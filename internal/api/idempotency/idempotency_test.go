@@ -0,0 +1,147 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_Stores(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	record := Record{
+		RequestHash: "hash",
+		StatusCode:  201,
+		Response:    json.RawMessage(`{"masterTrackingNo":"329014521622"}`),
+	}
+
+	stores := map[string]func(t *testing.T, clock func() time.Time) Store{
+		"memory": func(t *testing.T, clock func() time.Time) Store {
+			store := NewMemoryStore(time.Hour)
+			store.now = clock
+			return store
+		},
+		"file": func(t *testing.T, clock func() time.Time) Store {
+			store, err := NewFileStore(t.TempDir(), time.Hour)
+			if err != nil {
+				t.Fatalf("NewFileStore() error = %v", err)
+			}
+			store.now = clock
+			return store
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			current := now
+			store := newStore(t, func() time.Time { return current })
+			ctx := context.Background()
+
+			if _, err := store.Get(ctx, "unknown"); !errors.Is(err, ErrNotFound) {
+				t.Fatalf("Store.Get() error = %v, wantErr %v", err, ErrNotFound)
+			}
+
+			if err := store.Save(ctx, "key/../1", record); err != nil {
+				t.Fatalf("Store.Save() error = %v", err)
+			}
+
+			got, err := store.Get(ctx, "key/../1")
+			if err != nil {
+				t.Fatalf("Store.Get() error = %v", err)
+			}
+
+			want := record
+			want.CreatedAt = now
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("Store.Get() = %+v, want %+v", *got, want)
+			}
+
+			current = now.Add(2 * time.Hour)
+			if _, err := store.Get(ctx, "key/../1"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Store.Get() error = %v, wantErr %v for an expired record", err, ErrNotFound)
+			}
+		})
+	}
+}
+
+func Test_StoresSweep(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	record := Record{RequestHash: "hash", StatusCode: 201, Response: json.RawMessage(`{}`)}
+
+	dir := t.TempDir()
+	fileStore, err := NewFileStore(dir, time.Hour)
+	if err != nil {
+		t.Fatalf("NewFileStore() error = %v", err)
+	}
+	memoryStore := NewMemoryStore(time.Hour)
+
+	stores := map[string]struct {
+		store Store
+		clock *func() time.Time
+		count func() int
+	}{
+		"memory": {
+			store: memoryStore,
+			clock: &memoryStore.now,
+			count: func() int { return len(memoryStore.records) },
+		},
+		"file": {
+			store: fileStore,
+			clock: &fileStore.now,
+			count: func() int {
+				paths, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+				return len(paths)
+			},
+		},
+	}
+
+	for name, tt := range stores {
+		t.Run(name, func(t *testing.T) {
+			current := now
+			*tt.clock = func() time.Time { return current }
+			ctx := context.Background()
+
+			for _, key := range []string{"order-1", "order-2"} {
+				if err := tt.store.Save(ctx, key, record); err != nil {
+					t.Fatalf("Store.Save() error = %v", err)
+				}
+			}
+
+			// the first keys are never read again, the next save removes them
+			current = now.Add(2 * time.Hour)
+			if err := tt.store.Save(ctx, "order-3", record); err != nil {
+				t.Fatalf("Store.Save() error = %v", err)
+			}
+
+			if count := tt.count(); count != 1 {
+				t.Errorf("Store.Save() kept %d records, want only the new one", count)
+			}
+		})
+	}
+}
+
+func Test_Hash(t *testing.T) {
+	type body struct {
+		A string `json:"a"`
+		B int    `json:"b"`
+	}
+
+	first, err := Hash(body{A: "a", B: 1})
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	second, _ := Hash(&body{A: "a", B: 1})
+	other, _ := Hash(body{A: "a", B: 2})
+
+	if first != second {
+		t.Errorf("Hash() = %v and %v, want the same hash for the same body", first, second)
+	}
+
+	if first == other {
+		t.Errorf("Hash() = %v, want a different hash for a different body", other)
+	}
+}
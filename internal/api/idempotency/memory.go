@@ -0,0 +1,67 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps the records in memory, they are lost on restart.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	ttl       time.Duration
+	records   map[string]Record
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore(ttl time.Duration) *MemoryStore {
+	return &MemoryStore{
+		ttl:     ttl,
+		records: make(map[string]Record),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (*Record, error) {
+	s.mu.RLock()
+	record, ok := s.records[key]
+	s.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	if record.expired(s.now(), s.ttl) {
+		s.mu.Lock()
+		delete(s.records, key)
+		s.mu.Unlock()
+
+		return nil, ErrNotFound
+	}
+
+	return &record, nil
+}
+
+// Save also removes the expired records every sweepInterval, so the map does not grow
+// with keys that are never read again.
+func (s *MemoryStore) Save(ctx context.Context, key string, record Record) error {
+	now := s.now()
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = now
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sweepDue(&s.lastSweep, now, s.ttl) {
+		for storedKey, stored := range s.records {
+			if stored.expired(now, s.ttl) {
+				delete(s.records, storedKey)
+			}
+		}
+	}
+
+	s.records[key] = record
+	return nil
+}
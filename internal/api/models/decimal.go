@@ -0,0 +1,26 @@
+package models
+
+import "strconv"
+
+// Decimal is a number sent to Purolator in plain notation, encoding/xml
+// would write large floats with an exponent that Purolator rejects.
+type Decimal float64
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return strconv.AppendFloat(nil, float64(d), 'f', -1, 64), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = 0
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return err
+	}
+
+	*d = Decimal(value)
+	return nil
+}
@@ -2,6 +2,17 @@ package models
 
 import "encoding/xml"
 
+type GetDocumentsRequest struct {
+	OutputType        string             `xml:"OutputType"`
+	Synchronous       bool               `xml:"Synchronous"`
+	DocumentCriterium []DocumentCriteria `xml:"DocumentCriterium>DocumentCriteria"`
+}
+
+type DocumentCriteria struct {
+	Pin           string   `xml:"PIN>Value"`
+	DocumentTypes []string `xml:"DocumentTypes>DocumentType"`
+}
+
 type EnvelopeGetDocumentResponse struct {
 	XMLName xml.Name `xml:"Envelope"`
 	Header  struct {
@@ -16,11 +27,13 @@ type GetDocumentsResponse struct {
 }
 
 type DocumentInformation struct {
-	TrackingNo      string `xml:"PIN>Value"`
-	DocumentDetails []struct {
-		DocumentType   string `xml:"DocumentType"`
-		DocumentStatus string `xml:"DocumentStatus"`
-		URL            string `xml:"URL"`
-		Data           string `xml:"Data"`
-	} `xml:"DocumentDetails>DocumentDetail"`
+	TrackingNo      string           `xml:"PIN>Value"`
+	DocumentDetails []DocumentDetail `xml:"DocumentDetails>DocumentDetail"`
+}
+
+type DocumentDetail struct {
+	DocumentType   string `xml:"DocumentType"`
+	DocumentStatus string `xml:"DocumentStatus"`
+	URL            string `xml:"URL"`
+	Data           string `xml:"Data"`
 }
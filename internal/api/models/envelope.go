@@ -1,11 +1,29 @@
 package models
 
+import "encoding/xml"
+
+// PurolatorResponseError is embedded on every response body
+// to read the errors and messages sent by Purolator.
 type PurolatorResponseError struct {
-	Error *struct {
-		Code                  string `xml:"Code" json:"code,omitempty"`
-		Description           string `xml:"Description" json:"description,omitempty"`
-		AdditionalInformation string `xml:"AdditionalInformation" json:"additionalInformation,omitempty"`
-	} `xml:"ResponseInformation>Errors>Error,omitempty" json:"error,omitempty"`
+	ResponseInformation ResponseInformation `xml:"ResponseInformation" json:"responseInformation"`
+}
+
+// ResponseInformation holds every error and informational message
+// returned by Purolator on a response.
+type ResponseInformation struct {
+	Errors                []ResponseError        `xml:"Errors>Error"`
+	InformationalMessages []InformationalMessage `xml:"InformationalMessages>InformationalMessage"`
+}
+
+type ResponseError struct {
+	Code                  string `xml:"Code" json:"code"`
+	Description           string `xml:"Description" json:"description"`
+	AdditionalInformation string `xml:"AdditionalInformation" json:"additionalInformation,omitempty"`
+}
+
+type InformationalMessage struct {
+	Code    string `xml:"Code" json:"code"`
+	Message string `xml:"Message" json:"message"`
 }
 
 type RequestContext struct {
@@ -16,18 +34,22 @@ type RequestContext struct {
 	ResponseReference *string `xml:"ResponseReference,omitempty"`
 }
 
-type Envelope struct {
-	Soap   string `xml:"xmlns:soap,attr"`
-	Q2     string `xml:"xmlns:q2,attr"`
-	Header any
-	Body   any
+// EnvelopeFault is the envelope sent by Purolator when the request could not be processed at all,
+// like an authentication failure or a request that does not match the schema.
+type EnvelopeFault struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body struct {
+		Fault *Fault `xml:"Fault"`
+	} `xml:"Body"`
 }
 
-func NewEnvelope(header, body any) *Envelope {
-	return &Envelope{
-		Soap:   "http://schemas.xmlsoap.org/soap/envelope/",
-		Q2:     "http://purolator.com/pws/datatypes/v2",
-		Header: header,
-		Body:   body,
-	}
+type Fault struct {
+	Code   string `xml:"faultcode"`
+	String string `xml:"faultstring"`
+	Detail struct {
+		Content string `xml:",innerxml"`
+	} `xml:"detail"`
 }
@@ -0,0 +1,68 @@
+package models
+
+import "encoding/xml"
+
+type GetQuickEstimateRequest struct {
+	BillingAccountNumber string       `xml:"BillingAccountNumber"`
+	SenderPostalCode     string       `xml:"SenderPostalCode"`
+	ReceiverAddress      ShortAddress `xml:"ReceiverAddress"`
+	PackageType          string       `xml:"PackageType"`
+	TotalWeight          Weight       `xml:"TotalWeight"`
+}
+
+type ShortAddress struct {
+	City       string `xml:"City"`
+	Province   string `xml:"Province"`
+	Country    string `xml:"Country"`
+	PostalCode string `xml:"PostalCode"`
+}
+
+type GetFullEstimateRequest struct {
+	Shipment                         Shipment `xml:"Shipment"`
+	ShowAlternativeServicesIndicator bool     `xml:"ShowAlternativeServicesIndicator"`
+}
+
+type EnvelopeGetQuickEstimateResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body GetEstimateResponse `xml:"Body>GetQuickEstimateResponse"`
+}
+
+type EnvelopeGetFullEstimateResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body GetEstimateResponse `xml:"Body>GetFullEstimateResponse"`
+}
+
+type GetEstimateResponse struct {
+	PurolatorResponseError
+	ShipmentEstimates []ShipmentEstimate `xml:"ShipmentEstimates>ShipmentEstimate"`
+}
+
+type ShipmentEstimate struct {
+	ServiceID            string        `xml:"ServiceID"`
+	ShipmentDate         string        `xml:"ShipmentDate"`
+	ExpectedDeliveryDate string        `xml:"ExpectedDeliveryDate"`
+	EstimatedTransitDays int           `xml:"EstimatedTransitDays"`
+	BasePrice            float64       `xml:"BasePrice"`
+	Surcharges           []Charge      `xml:"Surcharges>Surcharge"`
+	Taxes                []Charge      `xml:"Taxes>Tax"`
+	OptionPrices         []OptionPrice `xml:"OptionPrices>OptionPrice"`
+	TotalPrice           float64       `xml:"TotalPrice"`
+}
+
+type Charge struct {
+	Amount      float64 `xml:"Amount"`
+	Type        string  `xml:"Type"`
+	Description string  `xml:"Description"`
+}
+
+type OptionPrice struct {
+	Amount      float64 `xml:"Amount"`
+	ID          string  `xml:"ID"`
+	Description string  `xml:"Description"`
+}
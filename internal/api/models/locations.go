@@ -0,0 +1,78 @@
+package models
+
+import "encoding/xml"
+
+// LocationSearchOptions narrows the locations returned by the Locator service.
+type LocationSearchOptions struct {
+	RadialDistanceInKM   float64 `xml:"RadialDistanceInKM,omitempty"`
+	MaxNumberofLocations int     `xml:"MaxNumberofLocations,omitempty"`
+}
+
+type GetLocationsByCityRequest struct {
+	City          string                `xml:"City"`
+	Province      string                `xml:"Province"`
+	Country       string                `xml:"Country"`
+	SearchOptions LocationSearchOptions `xml:"SearchOptions"`
+}
+
+type GetLocationsByPostalCodeRequest struct {
+	PostalCode    string                `xml:"PostalCode"`
+	SearchOptions LocationSearchOptions `xml:"SearchOptions"`
+}
+
+type GetLocationsByCoordinatesRequest struct {
+	Latitude      float64               `xml:"Latitude"`
+	Longitude     float64               `xml:"Longitude"`
+	SearchOptions LocationSearchOptions `xml:"SearchOptions"`
+}
+
+type EnvelopeGetLocationsByCityResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body GetLocationsResponse `xml:"Body>GetLocationsByCityResponse"`
+}
+
+type EnvelopeGetLocationsByPostalCodeResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body GetLocationsResponse `xml:"Body>GetLocationsByPostalCodeResponse"`
+}
+
+type EnvelopeGetLocationsByCoordinatesResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body GetLocationsResponse `xml:"Body>GetLocationsByCoordinatesResponse"`
+}
+
+type GetLocationsResponse struct {
+	PurolatorResponseError
+	Locations []Location `xml:"Locations>Location"`
+}
+
+// Location is a Purolator shipping centre, retail agent or locker, sorted by Purolator
+// from the closest to the farthest one of the searched place.
+type Location struct {
+	LocationID         string         `xml:"LocationId"`
+	LocationName       string         `xml:"LocationName"`
+	LocationType       string         `xml:"LocationType"`
+	Address            Address        `xml:"Address"`
+	Latitude           float64        `xml:"Latitude"`
+	Longitude          float64        `xml:"Longitude"`
+	RadialDistanceInKM float64        `xml:"RadialDistanceInKM"`
+	HoldForPickup      bool           `xml:"HoldForPickup"`
+	BusinessHours      []BusinessHour `xml:"BusinessHours>BusinessHour"`
+}
+
+// BusinessHour is the opening time of a location on one day of the week, the times come
+// as hh:mm on the local time of the location.
+type BusinessHour struct {
+	DayOfWeek string `xml:"DayOfWeek"`
+	OpenTime  string `xml:"OpenTime"`
+	CloseTime string `xml:"CloseTime"`
+}
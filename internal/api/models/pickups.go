@@ -0,0 +1,141 @@
+package models
+
+import "encoding/xml"
+
+// PickUpRequest is shared by the ValidatePickUp and SchedulePickUp operations.
+type PickUpRequest struct {
+	BillingAccountNumber string            `xml:"BillingAccountNumber"`
+	PartnerID            string            `xml:"PartnerID,omitempty"`
+	PickupInstruction    PickupInstruction `xml:"PickupInstruction"`
+	Address              Address           `xml:"Address"`
+	NotificationEmails   []string          `xml:"NotificationEmails>NotificationEmail,omitempty"`
+	ShipmentSummary      *ShipmentSummary  `xml:"ShipmentSummary,omitempty"`
+}
+
+type PickupInstruction struct {
+	Date                   string `xml:"Date"`
+	AnyTimeAfter           string `xml:"AnyTimeAfter"`
+	UntilTime              string `xml:"UntilTime"`
+	TotalWeight            Weight `xml:"TotalWeight"`
+	TotalPieces            int32  `xml:"TotalPieces"`
+	PickUpLocation         string `xml:"PickUpLocation,omitempty"`
+	AdditionalInstructions string `xml:"AdditionalInstructions,omitempty"`
+	TrailerAccessible      bool   `xml:"TrailerAccessible"`
+	LoadingDockAvailable   bool   `xml:"LoadingDockAvailable"`
+	ShipmentOnSkids        bool   `xml:"ShipmentOnSkids"`
+	NumberOfSkids          int32  `xml:"NumberOfSkids"`
+}
+
+type ShipmentSummary struct {
+	Details []ShipmentSummaryDetail `xml:"ShipmentSummaryDetails>ShipmentSummaryDetail"`
+}
+
+type ShipmentSummaryDetail struct {
+	DestinationCode string `xml:"DestinationCode"`
+	ModeOfTransport string `xml:"ModeOfTransport"`
+	TotalPieces     int32  `xml:"TotalPieces"`
+	TotalWeight     Weight `xml:"TotalWeight"`
+}
+
+type ModifyPickUpRequest struct {
+	BillingAccountNumber    string                  `xml:"BillingAccountNumber"`
+	ConfirmationNumber      string                  `xml:"ConfirmationNumber"`
+	ModifyPickupInstruction ModifyPickupInstruction `xml:"ModifyPickupInstruction"`
+}
+
+type ModifyPickupInstruction struct {
+	UntilTime            string `xml:"UntilTime"`
+	PickUpLocation       string `xml:"PickUpLocation,omitempty"`
+	TrailerAccessible    bool   `xml:"TrailerAccessible"`
+	LoadingDockAvailable bool   `xml:"LoadingDockAvailable"`
+	ShipmentOnSkids      bool   `xml:"ShipmentOnSkids"`
+	NumberOfSkids        int32  `xml:"NumberOfSkids"`
+}
+
+type VoidPickUpRequest struct {
+	PickUpConfirmationNumber string `xml:"PickUpConfirmationNumber"`
+}
+
+type GetPickUpHistoryRequest struct {
+	SearchCriteria PickUpHistorySearchCriteria `xml:"PickUpHistorySearchCriteria"`
+}
+
+type PickUpHistorySearchCriteria struct {
+	AccountNumber      string `xml:"AccountNumber"`
+	ConfirmationNumber string `xml:"ConfirmationNumber,omitempty"`
+	FromDate           string `xml:"FromDate,omitempty"`
+	ToDate             string `xml:"ToDate,omitempty"`
+	MaxNumOfRecords    int32  `xml:"MaxNumOfRecords,omitempty"`
+}
+
+type EnvelopeValidatePickUpResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body ValidatePickUpResponse `xml:"Body>ValidatePickUpResponse"`
+}
+
+type ValidatePickUpResponse struct {
+	PurolatorResponseError
+	IsBulkdRequired bool `xml:"IsBulkdRequired"`
+}
+
+type EnvelopeSchedulePickUpResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body PickUpConfirmationResponse `xml:"Body>SchedulePickUpResponse"`
+}
+
+type EnvelopeModifyPickUpResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body PickUpConfirmationResponse `xml:"Body>ModifyPickUpResponse"`
+}
+
+type PickUpConfirmationResponse struct {
+	PurolatorResponseError
+	PickUpConfirmationNumber string `xml:"PickUpConfirmationNumber"`
+}
+
+type EnvelopeVoidPickUpResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body VoidPickUpResponse `xml:"Body>VoidPickUpResponse"`
+}
+
+type VoidPickUpResponse struct {
+	PurolatorResponseError
+	PickUpVoided bool `xml:"PickUpVoided"`
+}
+
+type EnvelopeGetPickUpHistoryResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body GetPickUpHistoryResponse `xml:"Body>GetPickUpHistoryResponse"`
+}
+
+type GetPickUpHistoryResponse struct {
+	PurolatorResponseError
+	PickUps []PickUpDetail `xml:"PickUpHistory>PickUpDetail"`
+}
+
+type PickUpDetail struct {
+	BillingAccountNumber string `xml:"BillingAccountNumber"`
+	ConfirmationNumber   string `xml:"ConfirmationNumber"`
+	PickupStatus         string `xml:"PickupStatus"`
+	PickupDate           string `xml:"PickupDate"`
+	AnyTimeAfter         string `xml:"AnyTimeAfter"`
+	UntilTime            string `xml:"UntilTime"`
+	TotalPieces          int32  `xml:"TotalPieces"`
+	TotalWeight          Weight `xml:"TotalWeight"`
+	PickUpLocation       string `xml:"PickUpLocation"`
+}
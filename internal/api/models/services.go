@@ -0,0 +1,106 @@
+package models
+
+import "encoding/xml"
+
+type GetServicesOptionsRequest struct {
+	BillingAccountNumber string       `xml:"BillingAccountNumber"`
+	SenderAddress        ShortAddress `xml:"SenderAddress"`
+	ReceiverAddress      ShortAddress `xml:"ReceiverAddress"`
+}
+
+type EnvelopeGetServicesOptionsResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body GetServicesOptionsResponse `xml:"Body>GetServicesOptionsResponse"`
+}
+
+type GetServicesOptionsResponse struct {
+	PurolatorResponseError
+	Services []ServiceOptions `xml:"Services>Service"`
+}
+
+// ServiceOptions is a service available between two addresses and the options it allows.
+type ServiceOptions struct {
+	ID                     string          `xml:"ID"`
+	Description            string          `xml:"Description"`
+	PackageType            string          `xml:"PackageType"`
+	PackageTypeDescription string          `xml:"PackageTypeDescription"`
+	Options                []ServiceOption `xml:"Options>Option"`
+}
+
+// ServiceOption may have child options, like the class and mode of DangerousGoods.
+type ServiceOption struct {
+	ID                  string          `xml:"ID"`
+	Description         string          `xml:"Description"`
+	ValueType           string          `xml:"ValueType"`
+	AvailableForPieces  bool            `xml:"AvailableForPieces"`
+	PossibleValues      []OptionValue   `xml:"PossibleValues>OptionValue"`
+	ChildServiceOptions []ServiceOption `xml:"ChildServiceOptions>Option"`
+}
+
+type OptionValue struct {
+	Value       string `xml:"Value"`
+	Description string `xml:"Description"`
+}
+
+type ValidateCityPostalCodeZipRequest struct {
+	Addresses []ShortAddress `xml:"Addresses>ShortAddress"`
+}
+
+type EnvelopeValidateCityPostalCodeZipResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body ValidateCityPostalCodeZipResponse `xml:"Body>ValidateCityPostalCodeZipResponse"`
+}
+
+type ValidateCityPostalCodeZipResponse struct {
+	PurolatorResponseError
+	SuggestedAddresses []SuggestedAddress `xml:"SuggestedAddresses>SuggestedAddress"`
+}
+
+// SuggestedAddress answers one of the requested addresses, in the same order. The
+// errors tell the city, province and postal code do not match.
+type SuggestedAddress struct {
+	Address             ShortAddress        `xml:"Address"`
+	ResponseInformation ResponseInformation `xml:"ResponseInformation"`
+}
+
+type GetDeliveryTimesRequest struct {
+	ShipmentDetails []DeliveryShipmentDetail `xml:"ShipmentDetails>ShipmentDetail"`
+}
+
+type DeliveryShipmentDetail struct {
+	SenderAddress   ShortAddress `xml:"SenderAddress"`
+	ReceiverAddress ShortAddress `xml:"ReceiverAddress"`
+	ShipmentDate    string       `xml:"ShipmentDate"`
+}
+
+type EnvelopeGetDeliveryTimesResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body GetDeliveryTimesResponse `xml:"Body>GetDeliveryTimesResponse"`
+}
+
+type GetDeliveryTimesResponse struct {
+	PurolatorResponseError
+	ShipmentDetails []DeliveryTimes `xml:"ShipmentDetails>ShipmentDetail"`
+}
+
+// DeliveryTimes answers one of the requested shipment details, with the
+// delivery date of each service.
+type DeliveryTimes struct {
+	ServiceDetails []ServiceDeliveryTime `xml:"ServiceDetails>ServiceDetail"`
+}
+
+type ServiceDeliveryTime struct {
+	ServiceID    string `xml:"ServiceID"`
+	ServiceName  string `xml:"ServiceName"`
+	DeliveryDate string `xml:"DeliveryDate"`
+	DeliveryTime string `xml:"DeliveryTime"`
+}
@@ -2,6 +2,164 @@ package models
 
 import "encoding/xml"
 
+// CreateShipmentRequest mirrors the CreateShipmentRequest of the E-Ship v2 schema,
+// the order of the fields matches the sequence expected by Purolator.
+type CreateShipmentRequest struct {
+	Shipment    Shipment `xml:"Shipment"`
+	PrinterType string   `xml:"PrinterType"`
+}
+
+type Shipment struct {
+	SenderInformation            SenderInformation             `xml:"SenderInformation"`
+	ReceiverInformation          ReceiverInformation           `xml:"ReceiverInformation"`
+	ShipmentDate                 string                        `xml:"ShipmentDate"`
+	PackageInformation           PackageInformation            `xml:"PackageInformation"`
+	InternationalInformation     *InternationalInformation     `xml:"InternationalInformation,omitempty"`
+	ReturnShipmentInformation    *ReturnShipmentInformation    `xml:"ReturnShipmentInformation,omitempty"`
+	PaymentInformation           PaymentInformation            `xml:"PaymentInformation"`
+	PickupInformation            PickupInformation             `xml:"PickupInformation"`
+	TrackingReferenceInformation *TrackingReferenceInformation `xml:"TrackingReferenceInformation,omitempty"`
+}
+
+// InternationalInformation holds the customs data of a shipment leaving Canada.
+type InternationalInformation struct {
+	DocumentsOnlyIndicator          bool              `xml:"DocumentsOnlyIndicator"`
+	ContentDetails                  []ContentDetail   `xml:"ContentDetails>ContentDetail,omitempty"`
+	BuyerInformation                *BuyerInformation `xml:"BuyerInformation,omitempty"`
+	DutyInformation                 *DutyInformation  `xml:"DutyInformation,omitempty"`
+	ImportExportType                string            `xml:"ImportExportType,omitempty"`
+	CustomsInvoiceDocumentIndicator bool              `xml:"CustomsInvoiceDocumentIndicator"`
+}
+
+type ContentDetail struct {
+	Description          string `xml:"Description"`
+	HarmonizedCode       string `xml:"HarmonizedCode"`
+	CountryOfManufacture string `xml:"CountryOfManufacture"`
+	ProductCode          string `xml:"ProductCode,omitempty"`
+	// UnitValue is a decimal, formatted with two digits.
+	UnitValue              string `xml:"UnitValue"`
+	Quantity               int32  `xml:"Quantity"`
+	NAFTADocumentIndicator bool   `xml:"NAFTADocumentIndicator"`
+}
+
+type BuyerInformation struct {
+	Address   Address `xml:"Address"`
+	TaxNumber string  `xml:"TaxNumber,omitempty"`
+}
+
+type DutyInformation struct {
+	BillDutiesToParty    string `xml:"BillDutiesToParty"`
+	BusinessRelationship string `xml:"BusinessRelationship"`
+	Currency             string `xml:"Currency"`
+	AccountNumber        string `xml:"AccountNumber,omitempty"`
+}
+
+// ReturnShipmentInformation asks for prepaid return labels, printed with the shipment documents.
+type ReturnShipmentInformation struct {
+	NumberOfReturnShipments int32          `xml:"NumberOfReturnShipments"`
+	ReturnShipment          ReturnShipment `xml:"ReturnShipment"`
+}
+
+type ReturnShipment struct {
+	SenderInformation            SenderInformation             `xml:"SenderInformation"`
+	ReceiverInformation          ReceiverInformation           `xml:"ReceiverInformation"`
+	PackageInformation           PackageInformation            `xml:"PackageInformation"`
+	PaymentInformation           PaymentInformation            `xml:"PaymentInformation"`
+	PickupInformation            PickupInformation             `xml:"PickupInformation"`
+	TrackingReferenceInformation *TrackingReferenceInformation `xml:"TrackingReferenceInformation,omitempty"`
+}
+
+type SenderInformation struct {
+	Address   Address `xml:"Address"`
+	TaxNumber string  `xml:"TaxNumber,omitempty"`
+}
+
+type ReceiverInformation struct {
+	Address   Address `xml:"Address"`
+	TaxNumber string  `xml:"TaxNumber,omitempty"`
+}
+
+type Address struct {
+	Name         string      `xml:"Name"`
+	Company      string      `xml:"Company,omitempty"`
+	Department   string      `xml:"Department,omitempty"`
+	StreetNumber string      `xml:"StreetNumber"`
+	StreetSuffix string      `xml:"StreetSuffix,omitempty"`
+	StreetName   string      `xml:"StreetName"`
+	StreetType   string      `xml:"StreetType,omitempty"`
+	Suite        string      `xml:"Suite,omitempty"`
+	City         string      `xml:"City"`
+	Province     string      `xml:"Province"`
+	Country      string      `xml:"Country"`
+	PostalCode   string      `xml:"PostalCode"`
+	PhoneNumber  PhoneNumber `xml:"PhoneNumber"`
+}
+
+type PhoneNumber struct {
+	CountryCode string `xml:"CountryCode"`
+	AreaCode    string `xml:"AreaCode"`
+	Phone       string `xml:"Phone"`
+	Extension   string `xml:"Extension,omitempty"`
+}
+
+type PackageInformation struct {
+	ServiceID          string              `xml:"ServiceID"`
+	Description        string              `xml:"Description,omitempty"`
+	TotalWeight        Weight              `xml:"TotalWeight"`
+	TotalPieces        int32               `xml:"TotalPieces"`
+	PiecesInformation  *PiecesInformation  `xml:"PiecesInformation,omitempty"`
+	OptionsInformation *OptionsInformation `xml:"OptionsInformation,omitempty"`
+}
+
+type OptionsInformation struct {
+	Options              []OptionIDValuePair `xml:"Options>OptionIDValuePair"`
+	ExpressChequeAddress *Address            `xml:"ExpressChequeAddress,omitempty"`
+}
+
+// OptionIDValuePair is a single Purolator option, like SaturdayDelivery with the value true.
+type OptionIDValuePair struct {
+	ID    string `xml:"ID"`
+	Value string `xml:"Value"`
+}
+
+type PiecesInformation struct {
+	Pieces []Piece `xml:"Piece"`
+}
+
+type Piece struct {
+	Weight Weight     `xml:"Weight"`
+	Length *Dimension `xml:"Length,omitempty"`
+	Width  *Dimension `xml:"Width,omitempty"`
+	Height *Dimension `xml:"Height,omitempty"`
+}
+
+type Weight struct {
+	Value      Decimal `xml:"Value"`
+	WeightUnit string  `xml:"WeightUnit"`
+}
+
+type Dimension struct {
+	Value         Decimal `xml:"Value"`
+	DimensionUnit string  `xml:"DimensionUnit"`
+}
+
+type PaymentInformation struct {
+	PaymentType             string `xml:"PaymentType"`
+	RegisteredAccountNumber string `xml:"RegisteredAccountNumber"`
+	BillingAccountNumber    string `xml:"BillingAccountNumber,omitempty"`
+}
+
+type PickupInformation struct {
+	PickupType string `xml:"PickupType"`
+}
+
+type TrackingReferenceInformation struct {
+	Reference1 string `xml:"Reference1,omitempty"`
+	Reference2 string `xml:"Reference2,omitempty"`
+	Reference3 string `xml:"Reference3,omitempty"`
+	Reference4 string `xml:"Reference4,omitempty"`
+}
+
 type EnvelopeCreateShipmentResponse struct {
 	XMLName xml.Name `xml:"Envelope"`
 	Header  struct {
@@ -17,7 +175,24 @@ type CreateShipmentResponse struct {
 
 	PiecePINs          []string `xml:"PiecePINs>PIN>Value" json:"trackingNumbers,omitempty"`
 	ReturnShipmentPINs []string `xml:"ReturnShipmentPINs>PIN>Value" json:"returnTrackingNumber,omitempty"`
-	ExpressChequePIN   []string `xml:"ExpressChequePIN>PIN>Value" json:"expressChequePIN,omitempty"`
+	ExpressChequePIN   string   `xml:"ExpressChequePIN>Value" json:"expressChequePIN,omitempty"`
+}
+
+type ValidateShipmentRequest struct {
+	Shipment Shipment `xml:"Shipment"`
+}
+
+type EnvelopeValidateShipmentResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body ValidateShipmentResponse `xml:"Body>ValidateShipmentResponse"`
+}
+
+type ValidateShipmentResponse struct {
+	ResponseInformation ResponseInformation `xml:"ResponseInformation"`
+	ValidShipment       bool                `xml:"ValidShipment"`
 }
 
 type VoidShipmentRequest struct {
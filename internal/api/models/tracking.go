@@ -0,0 +1,61 @@
+package models
+
+import "encoding/xml"
+
+type PIN struct {
+	Value string `xml:"Value"`
+}
+
+type TrackPackagesByPinRequest struct {
+	PINs []PIN `xml:"PINs>PIN"`
+}
+
+type TrackPackagesByReferenceRequest struct {
+	SearchCriteria TrackPackageByReferenceSearchCriteria `xml:"TrackPackageByReferenceSearchCriteria"`
+}
+
+type TrackPackageByReferenceSearchCriteria struct {
+	Reference            string `xml:"Reference"`
+	BillingAccountNumber string `xml:"BillingAccountNumber"`
+	ShipmentFromDate     string `xml:"ShipmentFromDate,omitempty"`
+	ShipmentToDate       string `xml:"ShipmentToDate,omitempty"`
+}
+
+type EnvelopeTrackPackagesByPinResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body TrackPackagesResponse `xml:"Body>TrackPackagesByPinResponse"`
+}
+
+type EnvelopeTrackPackagesByReferenceResponse struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		ResponseContext RequestContext
+	} `xml:"Header"`
+	Body TrackPackagesResponse `xml:"Body>TrackPackagesByReferenceResponse"`
+}
+
+type TrackPackagesResponse struct {
+	PurolatorResponseError
+	TrackingInformationList []TrackingInformation `xml:"TrackingInformationList>TrackingInformation"`
+}
+
+type TrackingInformation struct {
+	TrackingNo string `xml:"PIN>Value"`
+	Scans      []Scan `xml:"Scans>Scan"`
+}
+
+// Scan is a single event on the life of a package, the ScanDate comes as
+// yyyy-mm-dd and the ScanTime as hhmmss on the local time of the depot.
+type Scan struct {
+	ScanType             string `xml:"ScanType"`
+	TrackingNo           string `xml:"PIN>Value"`
+	Depot                string `xml:"Depot>Name"`
+	ScanDate             string `xml:"ScanDate"`
+	ScanTime             string `xml:"ScanTime"`
+	Description          string `xml:"Description"`
+	Comment              string `xml:"Comment"`
+	SummaryScanIndicator bool   `xml:"SummaryScanIndicator"`
+}
@@ -14,14 +14,53 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (POST /addresses:validate)
+	ValidateAddresses(c *gin.Context)
+
+	// (GET /health)
+	GetHealth(c *gin.Context)
+
+	// (GET /locations)
+	ListLocations(c *gin.Context, params ListLocationsParams)
+
+	// (GET /pickups)
+	GetPickupHistory(c *gin.Context, params GetPickupHistoryParams)
+
+	// (POST /pickups)
+	SchedulePickup(c *gin.Context)
+
+	// (DELETE /pickups/{confirmationNo})
+	VoidPickup(c *gin.Context, confirmationNo string)
+
+	// (PATCH /pickups/{confirmationNo})
+	ModifyPickup(c *gin.Context, confirmationNo string)
+
+	// (POST /rates)
+	GetRates(c *gin.Context)
+
+	// (GET /services)
+	ListServices(c *gin.Context, params ListServicesParams)
+
+	// (GET /shipments)
+	ListShipments(c *gin.Context, params ListShipmentsParams)
+
 	// (POST /shipments)
-	CreateShipment(c *gin.Context)
+	CreateShipment(c *gin.Context, params CreateShipmentParams)
 
 	// (DELETE /shipments/{trackingNo})
 	VoidShipment(c *gin.Context, trackingNo string)
 
 	// (GET /shipments/{trackingNo})
-	GetDocument(c *gin.Context, trackingNo string)
+	GetDocument(c *gin.Context, trackingNo string, params GetDocumentParams)
+
+	// (GET /shipments/{trackingNo}/details)
+	GetShipmentDetails(c *gin.Context, trackingNo string, params GetShipmentDetailsParams)
+
+	// (GET /shipments/{trackingNo}/tracking)
+	TrackShipment(c *gin.Context, trackingNo string)
+
+	// (GET /tracking)
+	TrackByReference(c *gin.Context, params TrackByReferenceParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -33,9 +72,429 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// ValidateAddresses operation middleware
+func (siw *ServerInterfaceWrapper) ValidateAddresses(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ValidateAddresses(c)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealth(c)
+}
+
+// ListLocations operation middleware
+func (siw *ServerInterfaceWrapper) ListLocations(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLocationsParams
+
+	// ------------- Optional query parameter "postalCode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "postalCode", c.Request.URL.Query(), &params.PostalCode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter postalCode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "province" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "province", c.Request.URL.Query(), &params.Province)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter province: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "country" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "country", c.Request.URL.Query(), &params.Country)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter country: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "latitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "latitude", c.Request.URL.Query(), &params.Latitude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter latitude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "longitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "longitude", c.Request.URL.Query(), &params.Longitude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter longitude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", c.Request.URL.Query(), &params.Radius)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter radius: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "holdForPickup" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "holdForPickup", c.Request.URL.Query(), &params.HoldForPickup)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter holdForPickup: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLocations(c, params)
+}
+
+// GetPickupHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPickupHistory(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPickupHistoryParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "confirmationNo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "confirmationNo", c.Request.URL.Query(), &params.ConfirmationNo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirmationNo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPickupHistory(c, params)
+}
+
+// SchedulePickup operation middleware
+func (siw *ServerInterfaceWrapper) SchedulePickup(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SchedulePickup(c)
+}
+
+// VoidPickup operation middleware
+func (siw *ServerInterfaceWrapper) VoidPickup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "confirmationNo" -------------
+	var confirmationNo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "confirmationNo", c.Param("confirmationNo"), &confirmationNo, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirmationNo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VoidPickup(c, confirmationNo)
+}
+
+// ModifyPickup operation middleware
+func (siw *ServerInterfaceWrapper) ModifyPickup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "confirmationNo" -------------
+	var confirmationNo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "confirmationNo", c.Param("confirmationNo"), &confirmationNo, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter confirmationNo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ModifyPickup(c, confirmationNo)
+}
+
+// GetRates operation middleware
+func (siw *ServerInterfaceWrapper) GetRates(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRates(c)
+}
+
+// ListServices operation middleware
+func (siw *ServerInterfaceWrapper) ListServices(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServicesParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "toCountry" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toCountry", c.Request.URL.Query(), &params.ToCountry)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toCountry: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", c.Request.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListServices(c, params)
+}
+
+// ListShipments operation middleware
+func (siw *ServerInterfaceWrapper) ListShipments(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListShipmentsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "reference" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reference", c.Request.URL.Query(), &params.Reference)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reference: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "postalCode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "postalCode", c.Request.URL.Query(), &params.PostalCode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter postalCode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListShipments(c, params)
+}
+
 // CreateShipment operation middleware
 func (siw *ServerInterfaceWrapper) CreateShipment(c *gin.Context) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateShipmentParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -43,7 +502,7 @@ func (siw *ServerInterfaceWrapper) CreateShipment(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateShipment(c)
+	siw.Handler.CreateShipment(c, params)
 }
 
 // VoidShipment operation middleware
@@ -84,6 +543,141 @@ func (siw *ServerInterfaceWrapper) GetDocument(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDocumentParams
+
+	// ------------- Optional query parameter "documentType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "documentType", c.Request.URL.Query(), &params.DocumentType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter documentType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "output" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "output", c.Request.URL.Query(), &params.Output)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter output: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetDocument(c, trackingNo, params)
+}
+
+// GetShipmentDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetShipmentDetails(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "trackingNo" -------------
+	var trackingNo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackingNo", c.Param("trackingNo"), &trackingNo, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trackingNo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetShipmentDetailsParams
+
+	// ------------- Optional query parameter "documentType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "documentType", c.Request.URL.Query(), &params.DocumentType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter documentType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetShipmentDetails(c, trackingNo, params)
+}
+
+// TrackShipment operation middleware
+func (siw *ServerInterfaceWrapper) TrackShipment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "trackingNo" -------------
+	var trackingNo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackingNo", c.Param("trackingNo"), &trackingNo, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trackingNo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TrackShipment(c, trackingNo)
+}
+
+// TrackByReference operation middleware
+func (siw *ServerInterfaceWrapper) TrackByReference(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TrackByReferenceParams
+
+	// ------------- Required query parameter "reference" -------------
+
+	if paramValue := c.Query("reference"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument reference is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "reference", c.Request.URL.Query(), &params.Reference)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reference: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -91,7 +685,7 @@ func (siw *ServerInterfaceWrapper) GetDocument(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetDocument(c, trackingNo)
+	siw.Handler.TrackByReference(c, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -121,7 +715,20 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.POST(options.BaseURL+"/addresses:validate", wrapper.ValidateAddresses)
+	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
+	router.GET(options.BaseURL+"/locations", wrapper.ListLocations)
+	router.GET(options.BaseURL+"/pickups", wrapper.GetPickupHistory)
+	router.POST(options.BaseURL+"/pickups", wrapper.SchedulePickup)
+	router.DELETE(options.BaseURL+"/pickups/:confirmationNo", wrapper.VoidPickup)
+	router.PATCH(options.BaseURL+"/pickups/:confirmationNo", wrapper.ModifyPickup)
+	router.POST(options.BaseURL+"/rates", wrapper.GetRates)
+	router.GET(options.BaseURL+"/services", wrapper.ListServices)
+	router.GET(options.BaseURL+"/shipments", wrapper.ListShipments)
 	router.POST(options.BaseURL+"/shipments", wrapper.CreateShipment)
 	router.DELETE(options.BaseURL+"/shipments/:trackingNo", wrapper.VoidShipment)
 	router.GET(options.BaseURL+"/shipments/:trackingNo", wrapper.GetDocument)
+	router.GET(options.BaseURL+"/shipments/:trackingNo/details", wrapper.GetShipmentDetails)
+	router.GET(options.BaseURL+"/shipments/:trackingNo/tracking", wrapper.TrackShipment)
+	router.GET(options.BaseURL+"/tracking", wrapper.TrackByReference)
 }
@@ -18,30 +18,125 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xYb2/bthP+KgJ/BX4vJlu2k3aDgAHLkqAIMKRBnK0v2gw4U2eJjUSyFGUnCPTdB1L/",
-	"/1SJlxQo9sqKyLt77rmHx1MeCRWJFBy5Ton/SFIaYQL28SQIFKb2USohUWmG9i/K9IP51Q8SiU9SrRgP",
-	"iUvuZwIkm1ERYIh8hvdawUxDaI12ELMAtDFI4P7XowXJrYUKUBH/OHctEOAv9rzqel5azxnX6luei33v",
-	"cpdwSPCVE1vkLpGR4HiZJRvzok8mKIRTEdi4ErRGxYlP/v78OfjpDXG/jbeV178xr3EdarjK83pVbL4g",
-	"1e3VX4xbkWqIK1DfdPSz2arEjnE6vfFt7pq3iPry9etz1DivC/QS9+863i1ZCr9mTGFA/E+FwnoRO9m5",
-	"xelqUdPIt0NtV1a3/ZrkLjlVCBrXEZMJcn2NXzNM9VCAUjGuUd1Y80eCPEsM0psIVQIxcck1hlkM7Rjj",
-	"akzLSCMhgN5BiBd8K1QCmgk+3BNgShWT1eJkJMmQYjrprthinpjGxD68UbglPvmf13Q8r2x33pXZThpl",
-	"g1Lw8FTp75OY+KQ07Ve6BHA7cVissFHtGMWLs8mkzWnVQkN8VadV5E58wrg+WjUn1hQztKrqNFdr/RFZ",
-	"GOmnuCh39RNqkLqdYnWRdSNNZW9OnoQHo5jJSm5YHDMenlB7DFpt9KCmVcfq63yNPLB0XSNFtrOPNxFT",
-	"wRUo/TAp+oVlKGSpRoXBiwAuJ7vqsdU8vcvkE5o3W/oJnikhP2y3xCVXCtc0wiCLMRgmNongrU21IGgS",
-	"AzRDw5TGqtnCxIT712m9y+XwFFZwpoRoe5dVwX8ts0WrK59Zd31VPh7nM/Ozqn6ePEZaAb1jPLzGLSrk",
-	"dLqrq2rXcsiBTahcXk0vH00vH48sT6n53bC39cs/Lnd37CrrcTza1cbO73Tl+giry9XtXNjPufdHJvgE",
-	"TM+6KUt5KUbprSp9+aF7jw43tu/MPvC2F3cYeCyBM5YgT8enhGrpT850u8sxwzFNnhxSdhBneOD9OShG",
-	"4cQle3vLWShjeZwrJUYmflpOxm0Ew/i5SxJMUwhxXNxtPLQYCKv9Y1iKKWWAJXrWRNAUJHdJjDzU0UEm",
-	"+4PmDpfsWXBQhB4dZbgaauXQrdIdI6iZjboMPVsweUcPLWnGG+KSu3D8xj1UVt2Pi9wljG9FoSqugRaB",
-	"E2BmMgXJNELyW7qHMEQ1Z4JU37hkXbxzTq4unBuEhLgkU8Yo0lr6nteyybvznk+MzVYoR0foXGVKxKCF",
-	"+n/qmJYjGQ+dj7hx1sW4aKrAKPLUclgGP5FAI3RW80UnbOp73n6/n4NdngsVeqVt6v1xcXp+uT6freaL",
-	"eaST2PYdVEn6YVtFGsHu2S2eKRbTcTvvGjdxyQ5V0W3Icr6Y2+9DIZGDZMQnR/aVaeo6soLwqlZc9FVR",
-	"fFN1GSpasFPvdLLU8FIHdc5nhqw2UaY7Gt3Zu+EiqJ2sm8avik+430XwUFW8/NwCKWNGran3JS0aZ3FO",
-	"njpF4x+JVlndlG4idOo3O3QC0OCIrRVBCQyDOuM5aUtbqwyt1lMpTDUNqNVi+d2SSF8jAetgC1msXw1n",
-	"cSGMYMs43kukBgCWe8z4aIbBT2Rd6+3WvG705z3Wl6vICxHGWEx5Xfd/CRZ4p8Apxg7UKTo6Au1EkDob",
-	"RO5QS2LgbDLtcKHtNonBQJbGWUuUEhQkqFEZrP3AFT6H2zm4ortBIJwNOtQii20sZszMWWtaVZPkQFRu",
-	"i/h+b70dCO54yEyVSAvDD1l3l4Q40mfeo24XNIYNxoOCvUd9Jmj2CvX6zvVZPIPwKZpbiV6XnsmQ82vU",
-	"meJpV4iWOYe1pvMf8/iX/zGqqlfcnSS/zf8JAAD//5Apte7IFwAA",
+	"H4sIAAAAAAAC/9x9e2/duJX4VyH0K/BrsYqfcSZjYIH12JkZ72YS13ZaoNMsQEvn3staIjUkZfs28Hdf",
+	"8ClRoh43vk49/WcmvhLJQ/K8X/qSZKysGAUqRXL8JRHZCkqs/3mS5xyE/mfFWQVcEtB/ZUSu1f9L/PAe",
+	"6FKukuPDvTSR6wqS40RITugySZOHVwxX5FXGclgCfQUPkuNXEi/1HHe4IDmWakCJH/7zcC951CN4Djw5",
+	"PnpMNVyYdhc6eOJCB+FC+3qhmkquF4rMbN777jFNKC7hebe995gm1YpR+FCXN8D7J4854FOWazAqLCVw",
+	"mhwn//v3v+f/8YckHQa/tc2vGe7h2nTgweOjf8pu/gGZbD/9Xk3LhMSFA2pworfqVc7uCM3GX3zzmKpf",
+	"AeSHZ7+uw2Ytf1+t1d48bbE3wVoHaq2aSL2lHETGSSUJU9dQUyIR40g/TpHEt0DRgrMSyRUgAyC6XwFF",
+	"RCIi0D0nUgJVTzkk6bOB/FpdPoffasIhT45/NQTUObLgtlLDWlpX3VBngCohmXzu4thj6pjXXwyAhNFL",
+	"+K0GISM0Zd40fxAJpf7HHzgskuPk/+027HHX8sZdO7dapsQP52bI/l6alIS6vzxImHO8TrpH0Sw6E/gI",
+	"G+Yg6kJuDHYwa13oBUdhdevMhVTNOXTK+p9F8XGRHP8685A/px18V1htp0N4IYEjyniJC/JPQpeIyC7j",
+	"A84ZF326gTvga1RxdlNAiRaspnmK7olcabpZEChyhGmu/xL1cglCQo4yxjlkagpDU5qKFFkxCkk67ybe",
+	"KYjOQGJS9E6/Q/Oawloc74axAjANuXPnwsyY1J+5P4LoDd5hUuCbAj7ac+ndnHvhR8YvCGTm12FwFFfM",
+	"VqTIzYSRg2fmAZIrLFEOFdAcMXWS5hRTVJBb0MeeFVhdMs1RyXJAbIHOMF0CZ7X4ibFczD3w7iZHDl2p",
+	"HgG4X8bFqrmdcIPnZ0gyJOy+rlakKoFKex52e1dY1jzH6zMoiEZExvubG5fITAhyU8BfcFFvwr3Cs9Cj",
+	"xw7ktcHCGq71C18mpH0bETUWto+mB3QHVWYgqIG3h6WbXNmdm2LkePskVcM4eFfA70gWBc3c8BmOSW94",
+	"qCBTjMW9hpRATVFJhFD8TDOZi5qzAkvGUU5yRJlEHGTNqWU6g/t4rZHZzHtNylnLS1I6EtzfOz7cG5t/",
+	"U2JhDUvYNt0qxa/C2S1ezkHU4N2zmTvQ+p655fOzzfCnGdYlCDaC+j/UglAQ4mdW85gdVjARuVL1s8Ic",
+	"dZEIC7RaHZdligqW4ULxJcVY1R8WgNFTyvF6YqNqB0BjTB7oNqDY7x5lru9dL5raI4id3ekK82WEGnGp",
+	"FEr1r4XSGmRynOSsviladESdahoexQZ4LqcwsIcg+s3UQRfdEKMSqLRqQx8ZjJ78cfELpvUCZ7LmXXPt",
+	"15NXf/v85eDxD5O3PnOr6vZXmJeMkn9C7oy4YHTy8xXKrOzWEt3sIkk7duSXN+n+3uOkEUvxQuIzltVK",
+	"oJ7TnGSKK/ZXbS2FfqtxQRYEBFowroE4/XT1ywn6o0IA4MUafTj58frkTyi38ybpiHrzxliieZ3JSatV",
+	"MYzfakyldZh4jCNUHh4k2mAgZV227QVCJSyBd7msMvK86IOHrKgFuYNf3HjJa0gjGO0X2BvB7p6hFnKo",
+	"zg2ncVRrg9jadRSTOWAJTi0aNMwqrs6CO24OVO3j1+R6BUrbT9LkEpZ1gdv2XxxpLPO9gsIo7vONkKvu",
+	"yL41smL3ip1VJLs1loIZ4o0D5Fm/IwEreZTNgGvJusQn7Ln0D0QfB9UcExfn1Ny23c/YLs6HxjVicIPZ",
+	"Lvoj9DxrQ5IbzNMboeYh2W1dbTJNb4BG5gyUNrPBPJeRIXompWc5XN1ovqGBGiNpvhF0V70BLUxximWH",
+	"o75+fKX+d+D+N8n2JcfZLaHLS1gAB5ptghXXY2P72lB3N/Eri6JnZ99R1Ivh0ecRT2RfX7NLJGnAhsbm",
+	"+C7C2SJKGzxUHIQ4XSm294GNyo/X2sMk1Or2eD+wSYFcghB4uYFB2DokXPxiRk9ZyJV3BHTkroUTGSmj",
+	"WB7gbIX0+ykihiXqiRw75Ib/IzvlTIteeyIuzj9M+U8M/frj+xgeS3iQI1MZ1b/QplLL0NtIirwTkpRY",
+	"woBLywkOhblgPE9ECgR2VIoYLdYxsXKP+4LkTYucv3rTPdW7PWMEMz1atJAwJv9DN8eQ30n7ftqC/8e6",
+	"KNZG5kvIkzR5T0oiIf+z0TWIXvjTh8PD7w71P/bfvj5Sb4EQ1ytMj/b2bpfvHqCs5KjGoGnIanZu6Z+4",
+	"8g4maXJCptSNzpGZXdgZx9iHurEzUgIV0dPI3aNPlMg2aESbQeWkEuSdHiHm5ZCREhdIP06RACqVOtM4",
+	"HPTOIUd1pX5nFJAbYolZqX3CETPOtHKYpNvWUTfwyDjzIHKIWOJAD79Zy1H/iSJ7ZxVcSSxrMWn6udev",
+	"p03ANKl5sZlHLZi9B9vYaXTV6DNWgpAk+4EoHvYe52bp2M+Nxh1okp2Rg8+a4ae1kKwU5/SOkQx6PzQv",
+	"vmsLSa2ZVXLg52ZQyFbOICsw74r+humd1XLd0W9CyrhfMVThtdB4ndcKh0w4AD+ASH1kwGK8+vcaYQ7o",
+	"hhQF5Ejz447rwbzaBAvDBRuac3PaqZzZ2gViCnPV8DM95ppdYC7X7fs3GqW2oozilSh/0xr4JHO8sV6p",
+	"Syj00SldqT3zJTgG/YFJ98cUe8pqzoFmAYinJ8pd9unqbHR0j0b62x6AubXqGGdWR6kjNjGni5ETbcM+",
+	"GTPl90biUe+0+1U/te5dyNHNuuHFSn/KijrXbjWlAjBcoQWuC4nIAmG63tlyAOqwUScn+R53in9/Y5cg",
+	"KkYFeNvAiJlwa7WARV0Y/YZDxbhU28QUESFqsB7DcifZBBMy469wW4ixx/ZJxIKWJGptj5pR2ZRbaG9D",
+	"b6IORfaPtcIcl6Djn7gExDj676uPH1DFtL3iJDKhOhxo4plKwMtGh3Sqd85A6KhCiWVmwp+igmyKv9ig",
+	"aJR72jAp5EazcNBYKDQEjGofyC1l93Q8iBG/1vaC0attS4tGy+zCWSitXsXpXASkz7e3F7u+19Fi7Z3U",
+	"YKkDUMSQohw0JQvnGzc2MmpiuAGONV7sretZil7kiuUfF9ZDE7BkDXWSJhdMaPs7t79spBRb6PsrTdnX",
+	"ygS4dN6O0Fm4JT/W9jxHL9HHs00/zNhdKRT9CaTTPaOOEKe7zndUuOnGbdaW4cs2jMG0TdkGvBhv+Rlw",
+	"IVfRfVnDfP62rHvATDm1N+GtkK4dt+RY2WmeuWeEZzWRivNiuvbuBWI4vQ6c5UnqKZvdJqmfZUIH7KKR",
+	"gSltth47sqibaVCjmuPimhCcmysD5yMO9o7YMIYLIs1LKXKrtQWsISZ16qyWguSATjHFOe4JmRule3fW",
+	"nCdtovynJ3n6wDlzom2z6AcalL5W0wo/zkfuMGo5gdxZYA7OCPMtgQLH0kpUVpbAM6JdE3qGFPnkJRWC",
+	"1qG/YAkf8ttBZ235y2vl1yhAmEGOCRsXXIY5VwfXcIixeOFRyycgPtJiPRG1nFgrRYIhItEKKzr2Mc7c",
+	"Xks6mieWJnnf6h3luJ3XH9OElBXj8t2D+m/Xp3ChTHFq3ObXoF7EWp26hAoTY2sqsyb5PKXtDQoW5RF/",
+	"77IGnjPJkAPO1woXbkCZJrnDH0/Rdi3NXptLW0Fh7HUTf+j5koiQOGojuSfKq3ZLClaC5CBaabyAebaC",
+	"HFUF1q6TDbIXlGtxxYpcJ/BpsKKY57IxUIYpUu/7bRkdtcmNDCZDrApzOCKo952GoObz+UaY+jLCN976",
+	"HLxRkaHMfll3rPXpo1P4VjC6/JqxR62agVnZImPn4ZBek1w0z85mV7tEEm83hDfv7iEm/oI1WmSt0KAi",
+	"dHkKVHLQOqLiNidLQ+kFy271zmtqbLnPE3FGt46I6k8ODefjiptvMpO5mTq2/V9YThZrc06DSQkF0z7N",
+	"M5bd+uS08XxYtWODHB8XV7ckF9FMkGGX0WsbFf9UtRnfOEJxTArgJ1kGJttyOmO3ppIUAymCNPepC4bi",
+	"7wnN2b1iiT///Msv/Vye148T5SSde2kWj93LRdS0+/r0T5txt4llFmbwJj4AOuFABoqW5A5oiiSTuDDZ",
+	"06isRdvT0kRKzZzGv6ve/yuQ5Up7lomq7ihLpb3p33raYxOPnR89nSQXO+mYoXfUTYkccmnbl1LEuA5W",
+	"KtFagPShTDAiU6k2C1YU7N47OTspOA4VWxH6UcbfOvmvILzWPUydqX1rfuZnG7JwpSlv9EU02aZjT5Ci",
+	"IHR50o05bFa+5bMrujIhFj64XhGeG4/7VAyBw5IICRzyJwG4P6osKr2nUXg6qiLVCdEnC2lWHXV0Zowu",
+	"iD3pGRkYufXVjJ/rfJb+XWD3j3P+r8b2o6/D9o7k2MSJG5yp32FUAuhL/JkIyfg6qjQYwbQJA1Tvz+CA",
+	"ZtphoEZp0AzvRV45qz4uFsqJyuFKKfV1EXO5jOL2dx6CscI2H70QkteZ16ra9bRHT6ynPUoeOyTXssJm",
+	"VtF1iTEUIkJiLrergey3qPQpHlVtWWysEaq7o0ySBTHE/67suVTm5+jsP1G9/D7Ki4aiFjnXxq9YsbrI",
+	"jcSuK3MxRktz5U0/4Oz2jDGu5L0SEdVUnv/Rk7jX4ddyrw1V5bffWFXuJ4CYnMeAYtogjakVDWkO87Mo",
+	"d52SfxO8Pb4axGqlVrOur8mTUvRnOdkGQ+43RJJ7km+0Quc4vM5uQXUTpm67gwekEhwjUgUymznaCQgz",
+	"Qdoqsn7RVFb6WG+KNDvVsXWJ9ieTFWbGUvajtoOW660ZYvv8c02y29GYXld+GhcucGMXGkBsrPcdvYOC",
+	"VdD8ol5q/vqBPUx6J5yH72SeGLtaMS5bsszE1C7mtTXY25KJ0Vmyv4n59oWSJ+o6BtWKRV0UU4D6OK0u",
+	"gCHZ7dSABgta/jB34WaG1Ky8UWRKRpO27Q7j7ieXb7tx/K5J751QKpsVopDFA9CDju6ZKpbED9EmFfv7",
+	"T9P/9veTx4HmBtNoNlZb0WFtHCpMclf2WuAbKAQyqfk5wspF27invTe+HaEJD9BpTCEI4glFWnu9YpHn",
+	"S1L4dy222U7KxO+ljGULdSs9sTuE1z3cnHLqXcWu4t+NCe3pjYbcu+9IwwIuuC342DB05Fh9fs0xFUSe",
+	"4XXbvBswZFxN/lmnccCo2mK82hrQDaLzpkh6xL78boOa93620nhZZs0zvf424T0yaLbVOd940/Qr0ODt",
+	"mEO4wa3gONwWOrcaQBHTHcJMokjDIMnXQ04WyBjNBdKWpEq7ZRVQn0NUgBQI6145TdaoXHFWL1dJ2heZ",
+	"w/h90OCTyV7EZVVoHLFBxil3i5BYBtqhz2SyLQFWuFi80v/eKCfRAeVWGDnfoJA42uvjh3X/hAss1anh",
+	"LIMq0u/DwaLSorWnxc2EhORYwnIdcx5s4KvSJOem6kGXrQBXpgwvuxW2O8O9+kHjnFK1MkjRAgtp7h4Q",
+	"YF4Q9UewEx2+aoC3qa5mbnvGyDcdkQxhrlxKykplHN3AgvHW3lv5aW4ShW4GiCRtHfeGCWv2GEYuWWwn",
+	"ua/XHmbKOhjNoXPi22ZhfcO8SmsZM55vUPfo62D1uH6STdDjJtDc77FNU+SANaIYXmN6RJ1cnA+lfM4H",
+	"zml6U2A1IUybciUyTJtWEh7iNfTCdN8gD9Ud8Xsi4lm2FB7kac1FLN0s0787b5F6E1V42eo9ZF1HBRZy",
+	"qtPQfkv6b2A6dzBkGP8GK7THz2WwB1lzra4bWSeunJrCZUbBBOxvoJVM6G/fC42ualwX8oosKZY1h0sP",
+	"9lSeSB42AJui21gdrdEfm8KHyVKgSJVELF9sGHCl6DJOloT6HevCrzmbNpVDguRAJcGFn8CVIU51vksT",
+	"0emjNp3qIipQSaI/Y5oXlmWM4mj4ujuiqcBzB7X7PnXD2U46rYiwhFeSlDAVKtmkjcD3LSN9pmPydbei",
+	"azQ+vqUi+7ed7iejWnu0h0wQKJ/DeGzh7Haq5Tfg+Glyx0j+ddf/ZlRehDX6PjW/QbfWGY9xzqbc2Wtg",
+	"ZorEwT7pSQ9c44NdpEdPaU5v5oP5bYQP57YR7lcPzGtMO+XyjDOT3tk4D3jHRjOD0cqORuo1G2898cF+",
+	"N7fSp98re9L6EJN0ImonpzqcKL5z3VK0QpjhbiPR7yZ6dzdD8xQ9ZLSav0ZHQl9J418xfQegild9P0eZ",
+	"kCcwu/0YSYVb7RMCKx3HG0XcHComp/sPzMyEPHi0MPeS+HUyQKLBtmd61lRGfqL25LHxe3yUq4mScZ1G",
+	"R5Q4x2Uk89w2BCSlrxHV+0RNzoHQ+ei4qoDq697gpvyy7b1OV466CxvUqjfXdBtzY8r+G9Vrryd8212H",
+	"j31rPyrJ/OOD8ceH449fxxMCBtmIEvO2YfREqyRfKL/Novb9b9Ej6eB31i/o8Imtpu1VTTT+aaLc4UX/",
+	"HnvT+DSSbkOe4iZJk9tlPJ+v38EmmGa8plZNoAoObUqOxJlZWKWPKSSsiARc/pe4x8sl8B3CXFXIcXJl",
+	"flOuE3QNuExs75lkJWV1vLvbGtOVIYka4wxef/b/X6Ar67FFf4UbdOUt4YJkQE1fWrv4SYWzFaCDnb1g",
+	"WXG8u3t/f7+D9eMdxpe7dqzYfX9++u7D1btXBzt7OytZGoIGXoqPC09Ofdh39Su7SZpIIov2vj3cSnsF",
+	"bnosJfs7ezv6kw6sAoorkhwnh/on7Wldadzc9a36j5uo0xet8/Ux9nQF2a0mRdeS3reqB+G8m87T7Xta",
+	"mJJQgqke+emqNQRzQLgQTPlQDZkvMaFCtjtn3K9ItnId6oWt/ZXrFDllVU9rlFTTi9UxhTXKWdP3Ycf4",
+	"0E3PnPM8OfZc+sTBkxgMBiF/YPna4aFVXnBVFTajcfcfwsgjw9K+4ssE1p577GHj9ap9pNKezE7Spi1F",
+	"zcaxoPuO6Hs82Nt7TnjFEKzm6wm+EZ4FfbQV3o6hQV2PujWYtXSMAVlT744H+06amEDrr0lz85/Vz7sr",
+	"H1pagox1eqkYt0UdEsum66+NI91wwLdmv+brC70CkR4O/gTSxrOe8UKbcv6BW/S7GQD7BV2ZPS1zX0Ex",
+	"XfTKfiS2kVWzJz8IUcAc4TbvSBHW3EXX8ZheM6lpJiAkWhAu5A660rWqKpAFDziTxVqLZraw85zqafQk",
+	"OmXHsSldG+TqNM0jX3q5g66ZZpw6ZOQh1G2DtNrjPwgyUKarWKCw9Uax8lWrLjHa2r0GIXi5zyKVieDr",
+	"GZO0acojtJpH1BH/VhvbyYrD4Ds2DSb0lIX4aOuIGB3XzZ2yYoAtWsLBxxtbyVN27ijQjeNjg6WtjyRc",
+	"ud3p5vRkYL3Gu9Is12lljl/9M97NfPDsHHIFs0aUPvxglL7v91oa4Kvv+zrg4EIOb+eutP82WGr/bXyt",
+	"bsxe0xnHOalFWDg+cKrm1dlA7e1Fs9+GIbJDWzWNnprCe9/fG4CwICWRAYAenKO90Vy8CDiapG3KYLvC",
+	"3ZoERCBbLR2DxD6ax6q75dnzIdEfwYlU3AdNBGLwdeu6e2Tp7LfHx8/PKDqDYu4B6dns1n5lKRQYL0d2",
+	"vm8KxLX4bJWVRYXnT06imNK0sPYjYnj2VJugsq0vPiJI5Oa2nSGIQLYkJIYj6qUhFjovgWQIlx0YxvSe",
+	"gkOy54EiKLSRNj2gXX2CfEJWXMh0axDni7Y+q3NHIpml9Nksrp9yPMWCG6b3nLTdq7scoO8BfH8xZH3h",
+	"yjhtpKhPyM7SNXqirclUCq7VEYMvNwiEpUqRa3pshHTtajo9a34Omzks/hy9GFuEljYdXtQu/Wbc1yuM",
+	"ejtlRe9vfQdiCnos/JXkLxGpWpJi90vIUx5tUiDEPv11imkGBcLN7hqJ33HEMJJ7bBqVERHm16lIND2N",
+	"Mr12YeNnupuoXDU8qscZQ7QY45R9hvQ6kn5jYGmgeJm8QrnF+sCbRjFz7q3dUubJN/c8N7V9zhTrozNA",
+	"4RTuXeuycK/f1p+3CScq1e7Iy2VE3NWuxQWdCyrZjnlCdhqYKbPD+LiabDcXaap1UmDjJ3r3SoUA2u5/",
+	"EdNxda3dM0nBdqXikOvObU2yVnBMxcCQLiw0nixVW+gff1vs87WIAxtwUInG/9i7mxdkQ5nrNsjYTpeO",
+	"GlDKfdYOT4rW1m5A3gNQJO9Z2wMpWh/pdbmbPlUTq9ZBogmGRj+uuYM+CYh/m6sgOtUV9evu4q6/Ft6P",
+	"MvcW/G4p4TrojBhu83l5OroiR387vwhW503bnkGD7Qmrdxx/brWZzj/JTp/q/gvBaSpCsbT9HP1HFdqY",
+	"0fFSSWY+NhmD0Vq7TzJqn9N2a5cxDIXRulxEvCA24inLcpJ2xs0EK3GvDlYPpErx8P6nOGGvmtLJaadM",
+	"f8l/sXumD1DHUeO+JQH5AGySPTdkjcfGJ9HFAPEPZ+J9J7N3AzD8x8uazOc4SO3nGzDFzsIun0Wv7QNW",
+	"Lb49sPr8+FG4fFMK4nVtDneE1UKXfQysZopEnuoba3btvWMhqz36ioDAwb/UOdYtvYkwuBN9sMH+XxCD",
+	"bRINB91iJsu+dXtfYwKEqfpT/NQl2YTVVS7tJQxU87oAQ8CstrViCjwiB3Ap5+vLmo7HSnrIXFOiPtRx",
+	"C2urMxDh4VLRcA6SE8h9WWzThgKXZpjSRm9Y7gJAtqe4ZFyPMuiJ1PYA6/ZRfiOYMrkC7pfbQZdgbkCu",
+	"zNR6MfeaXmOhjWn9++uDgx13EivARtu0R3GeQ1kxCTRbv/ofWHcJrGkPd5R+K7/BQElHXHfxv9yBkme4",
+	"k0IDTSjt2xpzscTWycwgBfidTyay30Cqqf5+ur5Jg7haO9umB7b/udItnPaLZHCBCrn7pUnhH3XQKrfr",
+	"buOl9Y4EFbJV1aA3ANQrWDe11Ll06rUK8h4bVJPNZYKRz6jKjitj2ocb1Cls2X/rNvISPbihYBuN2/pU",
+	"TF9li/5oegv9KfSLxfxaZ83n4p9ymVu9vJ70Ui+oFd0Grf7FCdxBqIENfBAyKkfDr1DOu8zgQ5QRSI2n",
+	"xZ2OWyKE8eLsxyFTSo8OgPEFLXrQ3y7eR1OyI70utTD1emoADvp0+T7Vv6jWHW9eI6AZyyG3XFHJYLkK",
+	"Ya55MQAzq2VVx2E2g9SkSaqqBmKgP6dy2/l4U4QEL9u6jOMGmnbaH8X5vUmD3bz5vs045zDqW6MIEils",
+	"A/c0wBbjjLTNPzwrMEatiiMzGk967bR6+N0xGeVJ/ZcymG9h+7X6cAxqTkF0y9/H740u2g02xglD57LZ",
+	"fCidTWFQfVyc6rq2LWlHz6wNbQ+LmprAOO74fbYY6stFJL8bg0fbQJjQm2ts3cA5F0GiH9aXrRdG8cjP",
+	"ZL7S4b+86VdkVLN1Y48zOsMb+AQm+jt1JD+b+/hbUN6I724uAYqXSYG2zZpDfFN1lzx+fvy/AQAZh0xA",
+	"8ZsAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3,23 +3,85 @@
 // Code generated by github.com/deepmap/oapi-codegen/v2 version v2.1.0 DO NOT EDIT.
 package openapi
 
+import (
+	"time"
+)
+
+// Defines values for QuickRatePackageType.
+const (
+	CustomerPackaging QuickRatePackageType = "CustomerPackaging"
+	ExpressBox        QuickRatePackageType = "ExpressBox"
+	ExpressEnvelope   QuickRatePackageType = "ExpressEnvelope"
+	ExpressPack       QuickRatePackageType = "ExpressPack"
+)
+
+// Defines values for LocationType.
+const (
+	Locker         LocationType = "locker"
+	RetailAgent    LocationType = "retailAgent"
+	ShippingCentre LocationType = "shippingCentre"
+	Unknown        LocationType = "unknown"
+)
+
+// Defines values for ShipmentStatus.
+const (
+	Created ShipmentStatus = "created"
+	Voided  ShipmentStatus = "voided"
+)
+
 // Defines values for WeightWeightUnit.
 const (
 	Kg WeightWeightUnit = "kg"
 	Lb WeightWeightUnit = "lb"
 )
 
-// Defines values for CreateShipmentRequestShipmentPaymentInformationPaymentType.
+// Defines values for DutyInformationBillDutiesToParty.
+const (
+	DutyInformationBillDutiesToPartyBuyer    DutyInformationBillDutiesToParty = "Buyer"
+	DutyInformationBillDutiesToPartyReceiver DutyInformationBillDutiesToParty = "Receiver"
+	DutyInformationBillDutiesToPartySender   DutyInformationBillDutiesToParty = "Sender"
+)
+
+// Defines values for DutyInformationBusinessRelationship.
 const (
-	Receiver   CreateShipmentRequestShipmentPaymentInformationPaymentType = "Receiver"
-	Sender     CreateShipmentRequestShipmentPaymentInformationPaymentType = "Sender"
-	ThirdParty CreateShipmentRequestShipmentPaymentInformationPaymentType = "ThirdParty"
+	NotRelated DutyInformationBusinessRelationship = "NotRelated"
+	Related    DutyInformationBusinessRelationship = "Related"
 )
 
-// Defines values for CreateShipmentRequestShipmentPickupInformationPickupType.
+// Defines values for DutyInformationCurrency.
 const (
-	DropOff      CreateShipmentRequestShipmentPickupInformationPickupType = "DropOff"
-	PreScheduled CreateShipmentRequestShipmentPickupInformationPickupType = "PreScheduled"
+	CAD DutyInformationCurrency = "CAD"
+	USD DutyInformationCurrency = "USD"
+)
+
+// Defines values for InternationalInformationImportExportType.
+const (
+	Permanent InternationalInformationImportExportType = "Permanent"
+	Repair    InternationalInformationImportExportType = "Repair"
+	Return    InternationalInformationImportExportType = "Return"
+	Temporary InternationalInformationImportExportType = "Temporary"
+)
+
+// Defines values for DangerousGoodsOptionClass.
+const (
+	FullyRegulated      DangerousGoodsOptionClass = "FullyRegulated"
+	LessThan500kgExempt DangerousGoodsOptionClass = "LessThan500kgExempt"
+	LimitedQuantities   DangerousGoodsOptionClass = "LimitedQuantities"
+	UN1845              DangerousGoodsOptionClass = "UN1845"
+	UN3373              DangerousGoodsOptionClass = "UN3373"
+)
+
+// Defines values for DangerousGoodsOptionMode.
+const (
+	Air    DangerousGoodsOptionMode = "Air"
+	Ground DangerousGoodsOptionMode = "Ground"
+)
+
+// Defines values for PaymentInformationPaymentType.
+const (
+	PaymentInformationPaymentTypeReceiver   PaymentInformationPaymentType = "Receiver"
+	PaymentInformationPaymentTypeSender     PaymentInformationPaymentType = "Sender"
+	PaymentInformationPaymentTypeThirdParty PaymentInformationPaymentType = "ThirdParty"
 )
 
 // Defines values for CreateShipmentRequestPrinterType.
@@ -28,105 +90,579 @@ const (
 	Thermal CreateShipmentRequestPrinterType = "Thermal"
 )
 
+// Defines values for ExpressChequeOptionMethodOfPayment.
+const (
+	Cheque          ExpressChequeOptionMethodOfPayment = "Cheque"
+	PostDatedCheque ExpressChequeOptionMethodOfPayment = "PostDatedCheque"
+)
+
+// Defines values for PickupInformationPickupType.
+const (
+	DropOff      PickupInformationPickupType = "DropOff"
+	PreScheduled PickupInformationPickupType = "PreScheduled"
+)
+
 // Defines values for DimensionDimensionUnit.
 const (
 	Cm DimensionDimensionUnit = "cm"
 	In DimensionDimensionUnit = "in"
 )
 
+// Defines values for DocumentType.
+const (
+	CustomsInvoice                   DocumentType = "CustomsInvoice"
+	CustomsInvoiceThermal            DocumentType = "CustomsInvoiceThermal"
+	DangerousGoodsDeclaration        DocumentType = "DangerousGoodsDeclaration"
+	DomesticBillOfLading             DocumentType = "DomesticBillOfLading"
+	DomesticBillOfLadingThermal      DocumentType = "DomesticBillOfLadingThermal"
+	ExpressChequeReceipt             DocumentType = "ExpressChequeReceipt"
+	ExpressChequeReceiptThermal      DocumentType = "ExpressChequeReceiptThermal"
+	InternationalBillOfLading        DocumentType = "InternationalBillOfLading"
+	InternationalBillOfLadingThermal DocumentType = "InternationalBillOfLadingThermal"
+)
+
+// Defines values for HealthResStatus.
+const (
+	Degraded HealthResStatus = "degraded"
+	Ok       HealthResStatus = "ok"
+)
+
+// Defines values for RateRequestType.
+const (
+	Full  RateRequestType = "full"
+	Quick RateRequestType = "quick"
+)
+
+// Defines values for ServiceHealthState.
+const (
+	Closed   ServiceHealthState = "closed"
+	HalfOpen ServiceHealthState = "half-open"
+	Open     ServiceHealthState = "open"
+)
+
+// Defines values for ServiceSelectionStrategy.
+const (
+	Cheapest  ServiceSelectionStrategy = "cheapest"
+	DeliverBy ServiceSelectionStrategy = "deliverBy"
+	Fastest   ServiceSelectionStrategy = "fastest"
+)
+
+// Defines values for TrackingStatus.
+const (
+	Delivered TrackingStatus = "delivered"
+	Exception TrackingStatus = "exception"
+	InTransit TrackingStatus = "in-transit"
+)
+
+// Defines values for TrackingEventEventType.
+const (
+	Delivery      TrackingEventEventType = "Delivery"
+	Other         TrackingEventEventType = "Other"
+	PickUp        TrackingEventEventType = "PickUp"
+	Transit       TrackingEventEventType = "Transit"
+	Undeliverable TrackingEventEventType = "Undeliverable"
+)
+
+// Defines values for GetDocumentParamsFormat.
+const (
+	PDF GetDocumentParamsFormat = "PDF"
+	ZPL GetDocumentParamsFormat = "ZPL"
+)
+
+// Defines values for GetDocumentParamsOutput.
+const (
+	All  GetDocumentParamsOutput = "all"
+	Data GetDocumentParamsOutput = "data"
+	Url  GetDocumentParamsOutput = "url"
+)
+
+// SenderInformation defines model for SenderInformation.
+type SenderInformation struct {
+	Address   Address `json:"address"`
+	TaxNumber *string `json:"taxNumber,omitempty" validate:"max=11"`
+}
+
+// QuickRate defines model for QuickRate.
+type QuickRate struct {
+	SenderPostalCode string                `json:"senderPostalCode"`
+	ReceiverAddress  ShortAddress          `json:"receiverAddress"`
+	PackageType      *QuickRatePackageType `json:"packageType,omitempty"`
+	TotalWeight      Weight                `json:"totalWeight"`
+}
+
+// QuickRatePackageType defines model for QuickRate.PackageType.
+type QuickRatePackageType string
+
+// ReceiverInformation defines model for ReceiverInformation.
+type ReceiverInformation struct {
+	Address   Address `json:"address"`
+	TaxNumber *string `json:"taxNumber,omitempty" validate:"max=11"`
+}
+
+// ShortAddress defines model for ShortAddress.
+type ShortAddress struct {
+	City       string `json:"city"`
+	Province   string `json:"province"`
+	Country    string `json:"country"`
+	PostalCode string `json:"postalCode"`
+}
+
+// FullRate defines model for FullRate.
+type FullRate struct {
+	SenderInformation   SenderInformation   `json:"senderInformation"`
+	ReceiverInformation ReceiverInformation `json:"receiverInformation"`
+	ShipmentDate        *string             `json:"shipmentDate,omitempty"`
+	PackageInformation  PackageInformation  `json:"packageInformation"`
+}
+
+// LocationType defines model for LocationType.
+type LocationType string
+
+// ShipmentStatus defines model for ShipmentStatus.
+type ShipmentStatus string
+
 // Weight defines model for Weight.
 type Weight struct {
-	Value      int32            `json:"value"`
+	// Value decimal value, sent to Purolator rounded up to one decimal in the units of the account
+	Value      float64          `json:"value"`
 	WeightUnit WeightWeightUnit `json:"weightUnit"`
 }
 
 // WeightWeightUnit defines model for Weight.WeightUnit.
 type WeightWeightUnit string
 
+// DutyInformation who pays the duties and taxes, and the account they are billed to
+type DutyInformation struct {
+	BillDutiesToParty    DutyInformationBillDutiesToParty    `json:"billDutiesToParty"`
+	BusinessRelationship DutyInformationBusinessRelationship `json:"businessRelationship"`
+	Currency             DutyInformationCurrency             `json:"currency"`
+
+	// AccountNumber Purolator account billed for the duties and taxes
+	AccountNumber *string `json:"accountNumber,omitempty"`
+}
+
+// DutyInformationBillDutiesToParty defines model for DutyInformation.BillDutiesToParty.
+type DutyInformationBillDutiesToParty string
+
+// DutyInformationBusinessRelationship defines model for DutyInformation.BusinessRelationship.
+type DutyInformationBusinessRelationship string
+
+// DutyInformationCurrency defines model for DutyInformation.Currency.
+type DutyInformationCurrency string
+
+// PackageInformation defines model for PackageInformation.
+type PackageInformation struct {
+	// ServiceID Purolator service, or auto to let the server pick it following the serviceSelection of the shipment
+	ServiceID   string `json:"serviceID"`
+	Description string `json:"description"`
+	TotalWeight Weight `json:"totalWeight"`
+	TotalPieces int32  `json:"totalPieces"`
+
+	// PiecesInformation when given, totalPieces must match the number of pieces and totalWeight their summed weight
+	PiecesInformation *struct {
+		Pieces []Piece `json:"pieces"`
+	} `json:"piecesInformation,omitempty"`
+
+	// OptionsInformation Purolator options of the shipment, each one must be available for the service
+	OptionsInformation *ShipmentOptions `json:"optionsInformation,omitempty"`
+}
+
+// InternationalInformation customs information, required when the receiver is outside Canada
+type InternationalInformation struct {
+	// DocumentsOnlyIndicator the shipment only carries documents, so it has no content details
+	DocumentsOnlyIndicator *bool            `json:"documentsOnlyIndicator,omitempty"`
+	ContentDetails         *[]ContentDetail `json:"contentDetails,omitempty"`
+
+	// BuyerInformation required when the duties are billed to the buyer
+	BuyerInformation *ReceiverInformation `json:"buyerInformation,omitempty"`
+
+	// DutyInformation who pays the duties and taxes, and the account they are billed to
+	DutyInformation  *DutyInformation                          `json:"dutyInformation,omitempty"`
+	ImportExportType *InternationalInformationImportExportType `json:"importExportType,omitempty"`
+
+	// CustomsInvoiceDocumentIndicator generate the commercial invoice, available as the CustomsInvoice document. Defaults to true unless the shipment only carries documents
+	CustomsInvoiceDocumentIndicator *bool `json:"customsInvoiceDocumentIndicator,omitempty"`
+}
+
+// InternationalInformationImportExportType defines model for InternationalInformation.ImportExportType.
+type InternationalInformationImportExportType string
+
+// ReturnShipmentInformation prepaid return labels printed along with the shipment documents
+type ReturnShipmentInformation struct {
+	NumberOfReturnShipments int32 `json:"numberOfReturnShipments"`
+	ReturnShipment          struct {
+		SenderInformation            SenderInformation             `json:"senderInformation"`
+		ReceiverInformation          ReceiverInformation           `json:"receiverInformation"`
+		PackageInformation           PackageInformation            `json:"packageInformation"`
+		PaymentInformation           PaymentInformation            `json:"paymentInformation"`
+		PickupInformation            PickupInformation             `json:"pickupInformation"`
+		TrackingReferenceInformation *TrackingReferenceInformation `json:"trackingReferenceInformation,omitempty"`
+	} `json:"returnShipment"`
+}
+
+// SpecialHandlingOption defines model for SpecialHandlingOption.
+type SpecialHandlingOption struct {
+	// Type special handling type, like AdditionalHandling or LargePackage
+	Type string `json:"type"`
+}
+
+// DangerousGoodsOption defines model for DangerousGoodsOption.
+type DangerousGoodsOption struct {
+	Class DangerousGoodsOptionClass `json:"class"`
+	Mode  DangerousGoodsOptionMode  `json:"mode"`
+}
+
+// DangerousGoodsOptionClass defines model for DangerousGoodsOption.Class.
+type DangerousGoodsOptionClass string
+
+// DangerousGoodsOptionMode defines model for DangerousGoodsOption.Mode.
+type DangerousGoodsOptionMode string
+
+// PaymentInformation defines model for PaymentInformation.
+type PaymentInformation struct {
+	PaymentType             *PaymentInformationPaymentType `json:"paymentType,omitempty"`
+	RegisteredAccountNumber *string                        `json:"registeredAccountNumber,omitempty"`
+	BillingAccountNumber    *string                        `json:"billingAccountNumber,omitempty"`
+}
+
+// PaymentInformationPaymentType defines model for PaymentInformation.PaymentType.
+type PaymentInformationPaymentType string
+
+// ShipmentOptions Purolator options of the shipment, each one must be available for the service
+type ShipmentOptions struct {
+	ResidentialSignatureDomestic *bool                  `json:"residentialSignatureDomestic,omitempty"`
+	OriginSignatureNotRequired   *bool                  `json:"originSignatureNotRequired,omitempty"`
+	AdultSignatureRequired       *bool                  `json:"adultSignatureRequired,omitempty"`
+	SaturdayDelivery             *bool                  `json:"saturdayDelivery,omitempty"`
+	HoldForPickup                *bool                  `json:"holdForPickup,omitempty"`
+	SpecialHandling              *SpecialHandlingOption `json:"specialHandling,omitempty"`
+	DangerousGoods               *DangerousGoodsOption  `json:"dangerousGoods,omitempty"`
+
+	// ExpressCheque collect on delivery
+	ExpressCheque *ExpressChequeOption `json:"expressCheque,omitempty"`
+}
+
+// CreateShipmentRequest defines model for CreateShipmentRequest.
+type CreateShipmentRequest struct {
+	Shipment struct {
+		SenderInformation   SenderInformation   `json:"senderInformation"`
+		ReceiverInformation ReceiverInformation `json:"receiverInformation"`
+		ShipmentDate        string              `json:"shipmentDate"`
+		PackageInformation  PackageInformation  `json:"packageInformation"`
+
+		// InternationalInformation customs information, required when the receiver is outside Canada
+		InternationalInformation *InternationalInformation `json:"internationalInformation,omitempty"`
+
+		// ReturnShipmentInformation prepaid return labels printed along with the shipment documents
+		ReturnShipmentInformation    *ReturnShipmentInformation    `json:"returnShipmentInformation,omitempty"`
+		PaymentInformation           PaymentInformation            `json:"paymentInformation"`
+		PickupInformation            PickupInformation             `json:"pickupInformation"`
+		TrackingReferenceInformation *TrackingReferenceInformation `json:"trackingReferenceInformation,omitempty"`
+	} `json:"shipment"`
+	PrinterType CreateShipmentRequestPrinterType `json:"printerType"`
+
+	// ServiceSelection how to pick the service when the serviceID of the package is auto
+	ServiceSelection *ServiceSelection `json:"serviceSelection,omitempty"`
+}
+
+// CreateShipmentRequestPrinterType defines model for CreateShipmentRequest.PrinterType.
+type CreateShipmentRequestPrinterType string
+
+// ExpressChequeOption collect on delivery
+type ExpressChequeOption struct {
+	Amount          float64                            `json:"amount"`
+	MethodOfPayment ExpressChequeOptionMethodOfPayment `json:"methodOfPayment"`
+
+	// Address where the cheque is sent, defaults to the sender address
+	Address *Address `json:"address,omitempty"`
+}
+
+// ExpressChequeOptionMethodOfPayment defines model for ExpressChequeOption.MethodOfPayment.
+type ExpressChequeOptionMethodOfPayment string
+
+// PickupInformation defines model for PickupInformation.
+type PickupInformation struct {
+	PickupType *PickupInformationPickupType `json:"pickupType,omitempty"`
+}
+
+// PickupInformationPickupType defines model for PickupInformation.PickupType.
+type PickupInformationPickupType string
+
+// TrackingReferenceInformation defines model for TrackingReferenceInformation.
+type TrackingReferenceInformation struct {
+	Reference1 *string `json:"reference1,omitempty"`
+	Reference2 *string `json:"reference2,omitempty"`
+	Reference3 *string `json:"reference3,omitempty"`
+	Reference4 *string `json:"reference4,omitempty"`
+}
+
 // Address defines model for Address.
 type Address struct {
 	Name         string  `json:"name" validate:"max=30"`
 	Company      *string `json:"company,omitempty" validate:"max=20"`
 	StreetNumber string  `json:"streetNumber" validate:"max=6"`
 	StreetName   string  `json:"streetName" validate:"max=30"`
-	City         string  `json:"city" validate:"max=30"`
-	Province     string  `json:"province"`
-	Country      string  `json:"country"`
-	PostalCode   string  `json:"postalCode"`
-	PhoneNumber  struct {
+
+	// Suite unit or suite, taken from the street when it is written there
+	Suite       *string `json:"suite,omitempty" validate:"max=6"`
+	City        string  `json:"city" validate:"max=30"`
+	Province    string  `json:"province"`
+	Country     string  `json:"country"`
+	PostalCode  string  `json:"postalCode"`
+	PhoneNumber struct {
 		CountryCode *string `json:"countryCode,omitempty"`
 		AreaCode    *string `json:"areaCode,omitempty"`
 		Phone       *string `json:"phone,omitempty"`
 	} `json:"phoneNumber"`
 }
 
-// CreateShipmentRequest defines model for CreateShipmentRequest.
-type CreateShipmentRequest struct {
-	Shipment struct {
-		SenderInformation struct {
-			Address   Address `json:"address"`
-			TaxNumber *string `json:"taxNumber,omitempty" validate:"max=11"`
-		} `json:"senderInformation"`
-		ReceiverInformation struct {
-			Address   Address `json:"address"`
-			TaxNumber *string `json:"taxNumber,omitempty" validate:"max=11"`
-		} `json:"receiverInformation"`
-		ShipmentDate       string `json:"shipmentDate"`
-		PackageInformation struct {
-			ServiceID         string `json:"serviceID"`
-			Description       string `json:"description"`
-			TotalWeight       Weight `json:"totalWeight"`
-			TotalPieces       int32  `json:"totalPieces"`
-			PiecesInformation *struct {
-				Pieces []Piece `json:"pieces" xml:"Piece"`
-			} `json:"piecesInformation,omitempty"`
-		} `json:"packageInformation"`
-		PaymentInformation struct {
-			PaymentType             *CreateShipmentRequestShipmentPaymentInformationPaymentType `json:"paymentType,omitempty"`
-			RegisteredAccountNumber *string                                                     `json:"registeredAccountNumber,omitempty"`
-			BillingAccountNumber    *string                                                     `json:"billingAccountNumber,omitempty"`
-		} `json:"paymentInformation"`
-		PickupInformation struct {
-			PickupType *CreateShipmentRequestShipmentPickupInformationPickupType `json:"pickupType,omitempty"`
-		} `json:"pickupInformation"`
-		TrackingReferenceInformation *struct {
-			Reference1 *string `json:"reference1,omitempty"`
-			Reference2 *string `json:"reference2,omitempty"`
-			Reference3 *string `json:"reference3,omitempty"`
-			Reference4 *string `json:"reference4,omitempty"`
-		} `json:"trackingReferenceInformation,omitempty"`
-	} `json:"shipment"`
-	PrinterType CreateShipmentRequestPrinterType `json:"printerType"`
+// AddressValidationRequest defines model for AddressValidationRequest.
+type AddressValidationRequest struct {
+	Addresses []Address `json:"addresses"`
 }
 
-// CreateShipmentRequestShipmentPaymentInformationPaymentType defines model for CreateShipmentRequest.Shipment.PaymentInformation.PaymentType.
-type CreateShipmentRequestShipmentPaymentInformationPaymentType string
+// AddressValidationRes defines model for AddressValidationRes.
+type AddressValidationRes struct {
+	Results []AddressValidationResult `json:"results"`
+}
 
-// CreateShipmentRequestShipmentPickupInformationPickupType defines model for CreateShipmentRequest.Shipment.PickupInformation.PickupType.
-type CreateShipmentRequestShipmentPickupInformationPickupType string
+// AddressValidationResult defines model for AddressValidationResult.
+type AddressValidationResult struct {
+	Valid bool `json:"valid"`
 
-// CreateShipmentRequestPrinterType defines model for CreateShipmentRequest.PrinterType.
-type CreateShipmentRequestPrinterType string
+	// Address the address after normalizing it
+	Address Address `json:"address"`
+
+	// Errors every problem found, with the field and the suggested correction when there is one
+	Errors []ErrorDetail `json:"errors"`
+}
+
+// AvailableOption defines model for AvailableOption.
+type AvailableOption struct {
+	// Id ID to send on ShipmentOptions, like SaturdayDelivery or DangerousGoods
+	Id                 string                 `json:"id"`
+	Description        string                 `json:"description"`
+	ValueType          *string                `json:"valueType,omitempty"`
+	AvailableForPieces *bool                  `json:"availableForPieces,omitempty"`
+	PossibleValues     []AvailableOptionValue `json:"possibleValues"`
+
+	// ChildOptions options that depend on this one, like the class and mode of DangerousGoods
+	ChildOptions []AvailableOption `json:"childOptions"`
+}
+
+// AvailableOptionValue defines model for AvailableOptionValue.
+type AvailableOptionValue struct {
+	Value       string  `json:"value"`
+	Description *string `json:"description,omitempty"`
+}
+
+// AvailableService defines model for AvailableService.
+type AvailableService struct {
+	ServiceID              string  `json:"serviceID"`
+	Description            string  `json:"description"`
+	PackageType            *string `json:"packageType,omitempty"`
+	PackageTypeDescription *string `json:"packageTypeDescription,omitempty"`
+
+	// DeliveryDate expected delivery date, missing when Purolator did not return one
+	DeliveryDate *string `json:"deliveryDate,omitempty"`
+
+	// DeliveryTime expected delivery time, like 10:30
+	DeliveryTime *string           `json:"deliveryTime,omitempty"`
+	Options      []AvailableOption `json:"options"`
+}
+
+// BusinessHours defines model for BusinessHours.
+type BusinessHours struct {
+	Day string `json:"day"`
+
+	// Open opening time as hh:mm, local to the location
+	Open string `json:"open"`
+
+	// Close closing time as hh:mm, local to the location
+	Close string `json:"close"`
+}
+
+// Charge defines model for Charge.
+type Charge struct {
+	Type        string  `json:"type"`
+	Description *string `json:"description,omitempty"`
+	Amount      float64 `json:"amount"`
+}
+
+// ContentDetail defines model for ContentDetail.
+type ContentDetail struct {
+	Description string `json:"description"`
+
+	// HarmonizedCode HS code of the content
+	HarmonizedCode       string  `json:"harmonizedCode"`
+	CountryOfManufacture string  `json:"countryOfManufacture"`
+	ProductCode          *string `json:"productCode,omitempty"`
+	UnitValue            float64 `json:"unitValue"`
+	Quantity             int32   `json:"quantity"`
+
+	// NaftaDocumentIndicator the content qualifies for the CUSMA (formerly NAFTA) document
+	NaftaDocumentIndicator *bool `json:"naftaDocumentIndicator,omitempty"`
+}
 
 // CreateShipmentRes defines model for CreateShipmentRes.
 type CreateShipmentRes struct {
 	MasterTrackingNo string   `json:"masterTrackingNo"`
 	TrackingNOs      []string `json:"trackingNOs"`
+
+	// Pieces tracking number of each piece, in the order of the request pieces
+	Pieces            []PiecePIN             `json:"pieces"`
+	ReturnTrackingNOs *[]string              `json:"returnTrackingNOs,omitempty"`
+	ExpressChequeNo   *string                `json:"expressChequeNo,omitempty"`
+	Messages          []InformationalMessage `json:"messages"`
+
+	// SelectedService the service picked and its estimate, only when the serviceID was auto
+	SelectedService *ServiceEstimate `json:"selectedService,omitempty"`
 }
 
 // Dimension defines model for Dimension.
 type Dimension struct {
-	Value         int32                   `json:"value"`
+	// Value decimal value, sent to Purolator rounded up to one decimal in the units of the account
+	Value         float64                 `json:"value"`
 	DimensionUnit *DimensionDimensionUnit `json:"dimensionUnit,omitempty"`
 }
 
 // DimensionDimensionUnit defines model for Dimension.DimensionUnit.
 type DimensionDimensionUnit string
 
+// Document defines model for Document.
+type Document struct {
+	DocumentType   string  `json:"documentType"`
+	DocumentStatus string  `json:"documentStatus"`
+	Url            *string `json:"url,omitempty"`
+	Data           *[]byte `json:"data,omitempty"`
+}
+
+// DocumentType defines model for DocumentType.
+type DocumentType string
+
 // Error defines model for Error.
 type Error struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+
+	// Reference ResponseReference sent by Purolator, useful when reporting an issue to them.
+	Reference *string `json:"reference,omitempty"`
+
+	// Errors Every error returned by Purolator, including the soap fault if any.
+	Errors *[]ErrorDetail `json:"errors,omitempty"`
+}
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	Code                  string  `json:"code"`
+	Description           string  `json:"description"`
+	AdditionalInformation *string `json:"additionalInformation,omitempty"`
+
+	// Field parameter name or JSON pointer of the invalid field, set when the request does not match the spec
+	Field *string `json:"field,omitempty"`
+
+	// Suggestion corrected value of the field, when one is known
+	Suggestion *string `json:"suggestion,omitempty"`
+}
+
+// GetDocumentRes defines model for GetDocumentRes.
+type GetDocumentRes struct {
+	TrackingNo string     `json:"trackingNo"`
+	Documents  []Document `json:"documents"`
+}
+
+// HealthRes defines model for HealthRes.
+type HealthRes struct {
+	// Status degraded when the circuit of any service is not closed
+	Status   HealthResStatus `json:"status"`
+	Services []ServiceHealth `json:"services"`
+}
+
+// HealthResStatus degraded when the circuit of any service is not closed
+type HealthResStatus string
+
+// InformationalMessage defines model for InformationalMessage.
+type InformationalMessage struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Location defines model for Location.
+type Location struct {
+	Id   string       `json:"id"`
+	Name string       `json:"name"`
+	Type LocationType `json:"type"`
+
+	// Address ready to be used as the receiver address of a shipment held for pickup
+	Address   Address  `json:"address"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Distance distance in kilometres from the searched place
+	Distance *float64 `json:"distance,omitempty"`
+
+	// HoldForPickup the location can hold shipments sent with the holdForPickup option
+	HoldForPickup bool            `json:"holdForPickup"`
+	Hours         []BusinessHours `json:"hours"`
+}
+
+// LocationsRes defines model for LocationsRes.
+type LocationsRes struct {
+	Locations []Location `json:"locations"`
+}
+
+// ModifyPickupRequest defines model for ModifyPickupRequest.
+type ModifyPickupRequest struct {
+	// UntilTime end of the pickup window as HHMM
+	UntilTime            string  `json:"untilTime"`
+	PickUpLocation       *string `json:"pickUpLocation,omitempty"`
+	LoadingDockAvailable *bool   `json:"loadingDockAvailable,omitempty"`
+	TrailerAccessible    *bool   `json:"trailerAccessible,omitempty"`
+	NumberOfSkids        *int32  `json:"numberOfSkids,omitempty"`
+}
+
+// Pickup defines model for Pickup.
+type Pickup struct {
+	ConfirmationNo string  `json:"confirmationNo"`
+	Status         string  `json:"status"`
+	Date           *string `json:"date,omitempty"`
+	TotalWeight    *Weight `json:"totalWeight,omitempty"`
+	AnyTimeAfter   *string `json:"anyTimeAfter,omitempty"`
+	UntilTime      *string `json:"untilTime,omitempty"`
+	TotalPieces    *int32  `json:"totalPieces,omitempty"`
+	PickUpLocation *string `json:"pickUpLocation,omitempty"`
+}
+
+// PickupHistoryRes defines model for PickupHistoryRes.
+type PickupHistoryRes struct {
+	Pickups []Pickup `json:"pickups"`
+}
+
+// PickupRequest defines model for PickupRequest.
+type PickupRequest struct {
+	Date string `json:"date"`
+
+	// AnyTimeAfter start of the pickup window as HHMM
+	AnyTimeAfter string `json:"anyTimeAfter"`
+	TotalWeight  Weight `json:"totalWeight"`
+
+	// UntilTime end of the pickup window as HHMM
+	UntilTime   string `json:"untilTime"`
+	TotalPieces int32  `json:"totalPieces"`
+
+	// PickUpLocation where the driver should pick up the packages, like BackDoor or Reception
+	PickUpLocation         *string   `json:"pickUpLocation,omitempty"`
+	AdditionalInstructions *string   `json:"additionalInstructions,omitempty" validate:"max=25"`
+	LoadingDockAvailable   *bool     `json:"loadingDockAvailable,omitempty"`
+	TrailerAccessible      *bool     `json:"trailerAccessible,omitempty"`
+	NumberOfSkids          *int32    `json:"numberOfSkids,omitempty"`
+	NotificationEmails     *[]string `json:"notificationEmails,omitempty"`
+	Address                Address   `json:"address"`
+}
+
+// PickupRes defines model for PickupRes.
+type PickupRes struct {
+	ConfirmationNo string `json:"confirmationNo"`
 }
 
 // Piece defines model for Piece.
@@ -137,5 +673,278 @@ type Piece struct {
 	Width  Dimension `json:"width"`
 }
 
+// PiecePIN defines model for PiecePIN.
+type PiecePIN struct {
+	// PieceNo position of the piece on the request, starting at 1
+	PieceNo    int    `json:"pieceNo"`
+	TrackingNo string `json:"trackingNo"`
+}
+
+// RateRequest defines model for RateRequest.
+type RateRequest struct {
+	Type  RateRequestType `json:"type"`
+	Quick *QuickRate      `json:"quick,omitempty"`
+	Full  *FullRate       `json:"full,omitempty"`
+}
+
+// RateRequestType defines model for RateRequest.Type.
+type RateRequestType string
+
+// RatesRes defines model for RatesRes.
+type RatesRes struct {
+	Estimates []ServiceEstimate `json:"estimates"`
+}
+
+// ServiceEstimate defines model for ServiceEstimate.
+type ServiceEstimate struct {
+	ServiceID            string   `json:"serviceID"`
+	ShipmentDate         *string  `json:"shipmentDate,omitempty"`
+	ExpectedDeliveryDate *string  `json:"expectedDeliveryDate,omitempty"`
+	EstimatedTransitDays *int     `json:"estimatedTransitDays,omitempty"`
+	BasePrice            float64  `json:"basePrice"`
+	Surcharges           []Charge `json:"surcharges"`
+	Taxes                []Charge `json:"taxes"`
+	OptionPrices         []Charge `json:"optionPrices"`
+	TotalPrice           float64  `json:"totalPrice"`
+}
+
+// ServiceHealth defines model for ServiceHealth.
+type ServiceHealth struct {
+	Service string             `json:"service"`
+	State   ServiceHealthState `json:"state"`
+
+	// RetryAfter seconds until an open circuit lets a probe request through
+	RetryAfter *int32 `json:"retryAfter,omitempty"`
+}
+
+// ServiceHealthState defines model for ServiceHealth.State.
+type ServiceHealthState string
+
+// ServiceSelection defines model for ServiceSelection.
+type ServiceSelection struct {
+	// Strategy cheapest picks the lowest total price, fastest the earliest delivery date and deliverBy the cheapest service expected to arrive on or before deliverBy
+	Strategy ServiceSelectionStrategy `json:"strategy"`
+
+	// DeliverBy latest accepted delivery date, required by the deliverBy strategy
+	DeliverBy *string `json:"deliverBy,omitempty"`
+}
+
+// ServiceSelectionStrategy cheapest picks the lowest total price, fastest the earliest delivery date and deliverBy the cheapest service expected to arrive on or before deliverBy
+type ServiceSelectionStrategy string
+
+// ServicesRes defines model for ServicesRes.
+type ServicesRes struct {
+	Services []AvailableService `json:"services"`
+}
+
+// ShipmentDetailsRes defines model for ShipmentDetailsRes.
+type ShipmentDetailsRes struct {
+	TrackingNo string `json:"trackingNo"`
+
+	// Record missing when the shipment was not created through this API
+	Record    *ShipmentRecord `json:"record,omitempty"`
+	Documents []Document      `json:"documents"`
+
+	// Tracking missing when Purolator has no scans for the shipment yet
+	Tracking *Tracking `json:"tracking,omitempty"`
+}
+
+// ShipmentListRes defines model for ShipmentListRes.
+type ShipmentListRes struct {
+	Shipments []ShipmentRecord `json:"shipments"`
+
+	// NextCursor cursor of the next page, missing on the last one
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// ShipmentRecord defines model for ShipmentRecord.
+type ShipmentRecord struct {
+	TrackingNo         string                `json:"trackingNo"`
+	TrackingNOs        []string              `json:"trackingNOs"`
+	Status             ShipmentStatus        `json:"status"`
+	Reference          *string               `json:"reference,omitempty"`
+	ReceiverPostalCode *string               `json:"receiverPostalCode,omitempty"`
+	CreatedAt          time.Time             `json:"createdAt"`
+	VoidedAt           *time.Time            `json:"voidedAt,omitempty"`
+	Shipment           CreateShipmentRequest `json:"shipment"`
+	ReturnTrackingNOs  *[]string             `json:"returnTrackingNOs,omitempty"`
+	ExpressChequeNo    *string               `json:"expressChequeNo,omitempty"`
+}
+
+// Tracking defines model for Tracking.
+type Tracking struct {
+	TrackingNo string          `json:"trackingNo"`
+	Status     TrackingStatus  `json:"status"`
+	Events     []TrackingEvent `json:"events"`
+}
+
+// TrackingStatus defines model for Tracking.Status.
+type TrackingStatus string
+
+// TrackingEvent defines model for TrackingEvent.
+type TrackingEvent struct {
+	// Timestamp local time of the depot where the scan happened
+	Timestamp   string                 `json:"timestamp"`
+	Depot       *string                `json:"depot,omitempty"`
+	Description string                 `json:"description"`
+	Comment     *string                `json:"comment,omitempty"`
+	EventType   TrackingEventEventType `json:"eventType"`
+}
+
+// TrackingEventEventType defines model for TrackingEvent.EventType.
+type TrackingEventEventType string
+
+// TrackingListRes defines model for TrackingListRes.
+type TrackingListRes struct {
+	Shipments []Tracking `json:"shipments"`
+}
+
+// ValidateShipmentRes defines model for ValidateShipmentRes.
+type ValidateShipmentRes struct {
+	Valid    bool                   `json:"valid"`
+	Errors   []ErrorDetail          `json:"errors"`
+	Messages []InformationalMessage `json:"messages"`
+
+	// SelectedService the service picked and its estimate, only when the serviceID was auto
+	SelectedService *ServiceEstimate `json:"selectedService,omitempty"`
+}
+
+// ListLocationsParams defines parameters for ListLocations.
+type ListLocationsParams struct {
+	PostalCode *string `form:"postalCode,omitempty" json:"postalCode,omitempty"`
+	City       *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// Province province of the city, required along with city
+	Province *string `form:"province,omitempty" json:"province,omitempty"`
+
+	// Country country of the city, defaults to CA
+	Country   *string  `form:"country,omitempty" json:"country,omitempty"`
+	Latitude  *float64 `form:"latitude,omitempty" json:"latitude,omitempty"`
+	Longitude *float64 `form:"longitude,omitempty" json:"longitude,omitempty"`
+
+	// Radius search radius in kilometres
+	Radius *float64 `form:"radius,omitempty" json:"radius,omitempty"`
+
+	// Limit maximum number of locations, defaults to 10
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Type only return the locations of this type
+	Type *LocationType `form:"type,omitempty" json:"type,omitempty"`
+
+	// HoldForPickup only return the locations that can hold shipments for pickup
+	HoldForPickup *bool `form:"holdForPickup,omitempty" json:"holdForPickup,omitempty"`
+}
+
+// GetPickupHistoryParams defines parameters for GetPickupHistory.
+type GetPickupHistoryParams struct {
+	// From only pickups from this date
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To only pickups up to this date
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// ConfirmationNo only the pickup with this confirmation number
+	ConfirmationNo *string `form:"confirmationNo,omitempty" json:"confirmationNo,omitempty"`
+
+	// Limit maximum number of pickups to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListServicesParams defines parameters for ListServices.
+type ListServicesParams struct {
+	// From postal code of the sender
+	From string `form:"from" json:"from"`
+
+	// To postal code or ZIP code of the receiver
+	To string `form:"to" json:"to"`
+
+	// ToCountry country of the receiver, defaults to CA
+	ToCountry *string `form:"toCountry,omitempty" json:"toCountry,omitempty"`
+
+	// Date shipment date used for the delivery dates, defaults to today
+	Date *string `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// ListShipmentsParams defines parameters for ListShipments.
+type ListShipmentsParams struct {
+	// From only shipments created from this date
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To only shipments created up to this date, included
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Status only shipments with this status
+	Status *ShipmentStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Reference only shipments with this tracking reference
+	Reference *string `form:"reference,omitempty" json:"reference,omitempty"`
+
+	// PostalCode only shipments sent to this receiver postal code
+	PostalCode *string `form:"postalCode,omitempty" json:"postalCode,omitempty"`
+
+	// Cursor nextCursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit maximum number of shipments to return, defaults to 50
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateShipmentParams defines parameters for CreateShipment.
+type CreateShipmentParams struct {
+	// DryRun validate the shipment against the Purolator rules without creating it
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IdempotencyKey unique key for this shipment, a retried request with the same key and body returns the stored response instead of creating another shipment. Reusing the key with another body fails with 422.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetDocumentParams defines parameters for GetDocument.
+type GetDocumentParams struct {
+	// DocumentType type of document to retrieve, defaults to DomesticBillOfLading
+	DocumentType *DocumentType `form:"documentType,omitempty" json:"documentType,omitempty"`
+
+	// Format format of the document, defaults to PDF
+	Format *GetDocumentParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Output whether to return the document URL, the base64 encoded data or both, defaults to url
+	Output *GetDocumentParamsOutput `form:"output,omitempty" json:"output,omitempty"`
+}
+
+// GetDocumentParamsFormat defines parameters for GetDocument.
+type GetDocumentParamsFormat string
+
+// GetDocumentParamsOutput defines parameters for GetDocument.
+type GetDocumentParamsOutput string
+
+// GetShipmentDetailsParams defines parameters for GetShipmentDetails.
+type GetShipmentDetailsParams struct {
+	// DocumentType type of document to list, defaults to DomesticBillOfLading
+	DocumentType *DocumentType `form:"documentType,omitempty" json:"documentType,omitempty"`
+}
+
+// TrackByReferenceParams defines parameters for TrackByReference.
+type TrackByReferenceParams struct {
+	// Reference reference given to the shipments on its creation
+	Reference string `form:"reference" json:"reference"`
+
+	// From only shipments created from this date
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To only shipments created up to this date
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ValidateAddressesJSONRequestBody defines body for ValidateAddresses for application/json ContentType.
+type ValidateAddressesJSONRequestBody = AddressValidationRequest
+
+// SchedulePickupJSONRequestBody defines body for SchedulePickup for application/json ContentType.
+type SchedulePickupJSONRequestBody = PickupRequest
+
+// ModifyPickupJSONRequestBody defines body for ModifyPickup for application/json ContentType.
+type ModifyPickupJSONRequestBody = ModifyPickupRequest
+
+// GetRatesJSONRequestBody defines body for GetRates for application/json ContentType.
+type GetRatesJSONRequestBody = RateRequest
+
 // CreateShipmentJSONRequestBody defines body for CreateShipment for application/json ContentType.
 type CreateShipmentJSONRequestBody = CreateShipmentRequest
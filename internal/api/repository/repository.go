@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotFound      = errors.New("shipment not found")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrDatabase      = errors.New("could not access the database")
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+type Status string
+
+const (
+	StatusCreated Status = "created"
+	StatusVoided  Status = "voided"
+)
+
+// Shipment is the local record of a shipment created through the API.
+type Shipment struct {
+	TrackingNo         string
+	PiecePINs          []string
+	ReturnPINs         []string
+	ExpressChequePIN   string
+	Status             Status
+	Reference          string
+	ReceiverPostalCode string
+	// Request is the JSON body the shipment was created with.
+	Request   json.RawMessage
+	CreatedAt time.Time
+	VoidedAt  *time.Time
+}
+
+// ShipmentFilter selects the shipments to list, the zero value lists them all.
+type ShipmentFilter struct {
+	// From and To bound the creation time, From is included and To is not.
+	From       *time.Time
+	To         *time.Time
+	Status     Status
+	Reference  string
+	PostalCode string
+	// Cursor is the NextCursor of the previous page.
+	Cursor string
+	Limit  int
+}
+
+type ShipmentPage struct {
+	Shipments []Shipment
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+// Repository stores the shipments created through the API, listed newest first.
+type Repository interface {
+	SaveShipment(ctx context.Context, shipment Shipment) error
+	GetShipment(ctx context.Context, trackingNo string) (*Shipment, error)
+	VoidShipment(ctx context.Context, trackingNo string, voidedAt time.Time) error
+	ListShipments(ctx context.Context, filter ShipmentFilter) (*ShipmentPage, error)
+}
+
+// NormalizePostalCode removes the spaces and upper cases the postal code,
+// so "k1a 0b1" and "K1A0B1" match.
+func NormalizePostalCode(postalCode string) string {
+	return strings.ToUpper(strings.ReplaceAll(postalCode, " ", ""))
+}
+
+// cursor points to the last shipment of a page, by creation time and tracking number.
+type cursor struct {
+	createdAt  int64
+	trackingNo string
+}
+
+func (c cursor) encode() string {
+	value := strconv.FormatInt(c.createdAt, 10) + ":" + c.trackingNo
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+func decodeCursor(value string) (*cursor, error) {
+	const op string = "repository.decodeCursor"
+
+	content, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCursor)
+	}
+
+	createdAt, trackingNo, found := strings.Cut(string(content), ":")
+	if !found || len(trackingNo) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCursor)
+	}
+
+	nanos, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCursor)
+	}
+
+	return &cursor{createdAt: nanos, trackingNo: trackingNo}, nil
+}
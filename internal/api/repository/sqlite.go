@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	// Pure Go SQLite driver, registered as "sqlite".
+	_ "github.com/glebarez/go-sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS shipments (
+	tracking_no          TEXT PRIMARY KEY,
+	piece_pins           TEXT NOT NULL,
+	return_pins          TEXT NOT NULL,
+	express_cheque_pin   TEXT NOT NULL DEFAULT '',
+	status               TEXT NOT NULL,
+	reference            TEXT NOT NULL DEFAULT '',
+	receiver_postal_code TEXT NOT NULL DEFAULT '',
+	request              TEXT NOT NULL,
+	created_at           INTEGER NOT NULL,
+	voided_at            INTEGER
+);
+
+CREATE INDEX IF NOT EXISTS shipments_created_at ON shipments (created_at DESC, tracking_no DESC);
+CREATE INDEX IF NOT EXISTS shipments_reference ON shipments (reference);
+CREATE INDEX IF NOT EXISTS shipments_receiver_postal_code ON shipments (receiver_postal_code);
+`
+
+const shipmentColumns = `tracking_no, piece_pins, return_pins, express_cheque_pin, status,
+	reference, receiver_postal_code, request, created_at, voided_at`
+
+// SQLiteRepository keeps the shipments on a SQLite database file.
+type SQLiteRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteRepository opens the database on path, creating it and its tables
+// when needed. Use ":memory:" for a database that only lives as long as the process.
+func NewSQLiteRepository(path string) (*SQLiteRepository, error) {
+	const op string = "repository.NewSQLiteRepository"
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrDatabase, err)
+	}
+
+	// SQLite allows a single writer, and every connection to ":memory:" is a different database.
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%s: %w %w", op, ErrDatabase, err)
+	}
+
+	return &SQLiteRepository{db: db}, nil
+}
+
+func (r *SQLiteRepository) Close() error {
+	return r.db.Close()
+}
+
+func (r *SQLiteRepository) SaveShipment(ctx context.Context, shipment Shipment) error {
+	const op string = "repository.SaveShipment"
+
+	piecePINs, err := json.Marshal(nonNil(shipment.PiecePINs))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	returnPINs, err := json.Marshal(nonNil(shipment.ReturnPINs))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	status := shipment.Status
+	if len(status) == 0 {
+		status = StatusCreated
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO shipments (`+shipmentColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		shipment.TrackingNo,
+		string(piecePINs),
+		string(returnPINs),
+		shipment.ExpressChequePIN,
+		string(status),
+		shipment.Reference,
+		NormalizePostalCode(shipment.ReceiverPostalCode),
+		string(shipment.Request),
+		shipment.CreatedAt.UnixNano(),
+		unixNano(shipment.VoidedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w %w", op, ErrDatabase, err)
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository) GetShipment(ctx context.Context, trackingNo string) (*Shipment, error) {
+	const op string = "repository.GetShipment"
+
+	row := r.db.QueryRowContext(ctx, `SELECT `+shipmentColumns+` FROM shipments WHERE tracking_no = ?`, trackingNo)
+
+	shipment, err := scanShipment(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrDatabase, err)
+	}
+
+	return shipment, nil
+}
+
+func (r *SQLiteRepository) VoidShipment(ctx context.Context, trackingNo string, voidedAt time.Time) error {
+	const op string = "repository.VoidShipment"
+
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE shipments SET status = ?, voided_at = ? WHERE tracking_no = ?`,
+		string(StatusVoided),
+		voidedAt.UnixNano(),
+		trackingNo,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w %w", op, ErrDatabase, err)
+	}
+
+	if updated, err := result.RowsAffected(); err == nil && updated == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepository) ListShipments(ctx context.Context, filter ShipmentFilter) (*ShipmentPage, error) {
+	const op string = "repository.ListShipments"
+
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+
+	if filter.From != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.From.UnixNano())
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, filter.To.UnixNano())
+	}
+
+	if len(filter.Status) > 0 {
+		conditions = append(conditions, "status = ?")
+		args = append(args, string(filter.Status))
+	}
+
+	if len(filter.Reference) > 0 {
+		conditions = append(conditions, "reference = ?")
+		args = append(args, filter.Reference)
+	}
+
+	if len(filter.PostalCode) > 0 {
+		conditions = append(conditions, "receiver_postal_code = ?")
+		args = append(args, NormalizePostalCode(filter.PostalCode))
+	}
+
+	if len(filter.Cursor) > 0 {
+		after, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		conditions = append(conditions, "(created_at < ? OR (created_at = ? AND tracking_no < ?))")
+		args = append(args, after.createdAt, after.createdAt, after.trackingNo)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	query := `SELECT ` + shipmentColumns + ` FROM shipments`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	// One more than the limit tells if there is a next page.
+	query += ` ORDER BY created_at DESC, tracking_no DESC LIMIT ?`
+	args = append(args, limit+1)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrDatabase, err)
+	}
+	defer rows.Close()
+
+	page := &ShipmentPage{Shipments: make([]Shipment, 0, limit)}
+	for rows.Next() {
+		shipment, err := scanShipment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w %w", op, ErrDatabase, err)
+		}
+
+		page.Shipments = append(page.Shipments, *shipment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrDatabase, err)
+	}
+
+	if len(page.Shipments) > limit {
+		page.Shipments = page.Shipments[:limit]
+
+		last := page.Shipments[limit-1]
+		page.NextCursor = cursor{createdAt: last.CreatedAt.UnixNano(), trackingNo: last.TrackingNo}.encode()
+	}
+
+	return page, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanShipment(row scanner) (*Shipment, error) {
+	var (
+		shipment   Shipment
+		piecePINs  string
+		returnPINs string
+		status     string
+		request    string
+		createdAt  int64
+		voidedAt   sql.NullInt64
+	)
+
+	err := row.Scan(
+		&shipment.TrackingNo,
+		&piecePINs,
+		&returnPINs,
+		&shipment.ExpressChequePIN,
+		&status,
+		&shipment.Reference,
+		&shipment.ReceiverPostalCode,
+		&request,
+		&createdAt,
+		&voidedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(piecePINs), &shipment.PiecePINs); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(returnPINs), &shipment.ReturnPINs); err != nil {
+		return nil, err
+	}
+
+	shipment.Status = Status(status)
+	shipment.Request = json.RawMessage(request)
+	shipment.CreatedAt = time.Unix(0, createdAt).UTC()
+
+	if voidedAt.Valid {
+		voided := time.Unix(0, voidedAt.Int64).UTC()
+		shipment.VoidedAt = &voided
+	}
+
+	return &shipment, nil
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+func unixNano(value *time.Time) *int64 {
+	if value == nil {
+		return nil
+	}
+
+	nanos := value.UnixNano()
+	return &nanos
+}
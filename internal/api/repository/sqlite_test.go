@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestRepository(t *testing.T) *SQLiteRepository {
+	t.Helper()
+
+	repo, err := NewSQLiteRepository(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository() error = %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+
+	return repo
+}
+
+func Test_SaveAndVoidShipment(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	shipment := Shipment{
+		TrackingNo:         "329014521622",
+		PiecePINs:          []string{"329014521622", "329014521630"},
+		ReturnPINs:         []string{"329014521648"},
+		ExpressChequePIN:   "329014521655",
+		Status:             StatusCreated,
+		Reference:          "order-1",
+		ReceiverPostalCode: "k1a 0b1",
+		Request:            json.RawMessage(`{"shipment":{}}`),
+		CreatedAt:          createdAt,
+	}
+
+	if err := repo.SaveShipment(ctx, shipment); err != nil {
+		t.Fatalf("SaveShipment() error = %v", err)
+	}
+
+	got, err := repo.GetShipment(ctx, shipment.TrackingNo)
+	if err != nil {
+		t.Fatalf("GetShipment() error = %v", err)
+	}
+
+	want := shipment
+	want.ReceiverPostalCode = "K1A0B1"
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("GetShipment() = %+v, want %+v", *got, want)
+	}
+
+	voidedAt := createdAt.Add(time.Hour)
+	if err := repo.VoidShipment(ctx, shipment.TrackingNo, voidedAt); err != nil {
+		t.Fatalf("VoidShipment() error = %v", err)
+	}
+
+	got, _ = repo.GetShipment(ctx, shipment.TrackingNo)
+	if got.Status != StatusVoided || got.VoidedAt == nil || !got.VoidedAt.Equal(voidedAt) {
+		t.Errorf("GetShipment() = %v at %v, want %v at %v", got.Status, got.VoidedAt, StatusVoided, voidedAt)
+	}
+
+	if _, err := repo.GetShipment(ctx, "unknown"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetShipment() error = %v, wantErr %v", err, ErrNotFound)
+	}
+
+	if err := repo.VoidShipment(ctx, "unknown", voidedAt); !errors.Is(err, ErrNotFound) {
+		t.Errorf("VoidShipment() error = %v, wantErr %v", err, ErrNotFound)
+	}
+}
+
+func Test_ListShipments(t *testing.T) {
+	repo := newTestRepository(t)
+	ctx := context.Background()
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	shipments := []Shipment{
+		{TrackingNo: "1", Reference: "order-1", ReceiverPostalCode: "K1A 0B1", CreatedAt: day},
+		{TrackingNo: "2", Reference: "order-2", ReceiverPostalCode: "L4W 5M8", CreatedAt: day.Add(time.Hour)},
+		{TrackingNo: "3", Reference: "order-1", ReceiverPostalCode: "K1A 0B1", CreatedAt: day.AddDate(0, 0, 1)},
+		{TrackingNo: "4", Reference: "order-3", ReceiverPostalCode: "K1A 0B1", CreatedAt: day.AddDate(0, 0, 2), Status: StatusVoided},
+	}
+	for _, shipment := range shipments {
+		shipment.Request = json.RawMessage(`{}`)
+		if err := repo.SaveShipment(ctx, shipment); err != nil {
+			t.Fatalf("SaveShipment() error = %v", err)
+		}
+	}
+
+	from := day
+	to := day.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name   string
+		filter ShipmentFilter
+		want   []string
+	}{
+		{
+			name:   "Should list every shipment, newest first",
+			filter: ShipmentFilter{},
+			want:   []string{"4", "3", "2", "1"},
+		},
+		{
+			name:   "Should filter by creation date, excluding the end",
+			filter: ShipmentFilter{From: &from, To: &to},
+			want:   []string{"2", "1"},
+		},
+		{
+			name:   "Should filter by status",
+			filter: ShipmentFilter{Status: StatusVoided},
+			want:   []string{"4"},
+		},
+		{
+			name:   "Should filter by reference",
+			filter: ShipmentFilter{Reference: "order-1"},
+			want:   []string{"3", "1"},
+		},
+		{
+			name:   "Should filter by the normalized postal code",
+			filter: ShipmentFilter{PostalCode: "k1a0b1", Status: StatusCreated},
+			want:   []string{"3", "1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := repo.ListShipments(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("ListShipments() error = %v", err)
+			}
+
+			got := make([]string, 0, len(page.Shipments))
+			for _, shipment := range page.Shipments {
+				got = append(got, shipment.TrackingNo)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListShipments() = %v, want %v", got, tt.want)
+			}
+
+			if len(page.NextCursor) > 0 {
+				t.Errorf("ListShipments() next cursor = %q, want none", page.NextCursor)
+			}
+		})
+	}
+
+	t.Run("Should page with the cursor", func(t *testing.T) {
+		got := make([]string, 0)
+		filter := ShipmentFilter{Limit: 3}
+
+		for pages := 0; ; pages++ {
+			if pages > len(shipments) {
+				t.Fatalf("ListShipments() did not stop paging")
+			}
+
+			page, err := repo.ListShipments(ctx, filter)
+			if err != nil {
+				t.Fatalf("ListShipments() error = %v", err)
+			}
+
+			for _, shipment := range page.Shipments {
+				got = append(got, shipment.TrackingNo)
+			}
+
+			if len(page.NextCursor) == 0 {
+				break
+			}
+			filter.Cursor = page.NextCursor
+		}
+
+		want := []string{"4", "3", "2", "1"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ListShipments() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Should reject an invalid cursor", func(t *testing.T) {
+		_, err := repo.ListShipments(ctx, ShipmentFilter{Cursor: "not a cursor"})
+		if !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("ListShipments() error = %v, wantErr %v", err, ErrInvalidCursor)
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package soap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitOpen     CircuitState = "open"
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// halfOpenRetryAfter is suggested to the requests rejected while the probe is running.
+const halfOpenRetryAfter = time.Second
+
+// BreakerSettings controls when a circuit opens and for how long.
+type BreakerSettings struct {
+	// FailureThreshold is the number of consecutive failures that opens the circuit.
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before letting a probe request through.
+	OpenTimeout time.Duration
+}
+
+var DefaultBreakerSettings = BreakerSettings{
+	FailureThreshold: 5,
+	OpenTimeout:      30 * time.Second,
+}
+
+// CircuitOpenError is returned, without calling Purolator, while the circuit of a service is open.
+type CircuitOpenError struct {
+	Service    string
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s: %s service, retry after %s", ErrCircuitOpen, e.Service, e.RetryAfter)
+}
+
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// CircuitBreaker stops calling a service after it failed several times in a row,
+// then lets a single probe request through once the open timeout is over.
+type CircuitBreaker struct {
+	mu       sync.Mutex
+	name     string
+	settings BreakerSettings
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+	now      func() time.Time
+}
+
+func NewCircuitBreaker(name string, settings BreakerSettings) *CircuitBreaker {
+	return &CircuitBreaker{
+		name:     name,
+		settings: settings,
+		state:    CircuitClosed,
+		now:      time.Now,
+	}
+}
+
+// allow tells if a request can be sent, otherwise it returns the error for the caller.
+func (b *CircuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		remaining := b.settings.OpenTimeout - b.now().Sub(b.openedAt)
+		if remaining > 0 {
+			return &CircuitOpenError{Service: b.name, RetryAfter: remaining}
+		}
+
+		b.state = CircuitHalfOpen
+		b.probing = true
+		return nil
+	case CircuitHalfOpen:
+		if b.probing {
+			return &CircuitOpenError{Service: b.name, RetryAfter: halfOpenRetryAfter}
+		}
+
+		b.probing = true
+		return nil
+	}
+
+	return nil
+}
+
+// record updates the circuit with the outcome of a request let through by allow.
+func (b *CircuitBreaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.state = CircuitClosed
+		b.failures = 0
+		b.probing = false
+		return
+	}
+
+	b.failures++
+	if b.state == CircuitHalfOpen || b.failures >= b.settings.FailureThreshold {
+		b.state = CircuitOpen
+		b.openedAt = b.now()
+		b.probing = false
+	}
+}
+
+// release frees the probe slot of a request that says nothing about the service, leaving
+// the state and the failure count as they were.
+func (b *CircuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// State returns the state of the circuit and, while open, the time until a probe is let through.
+func (b *CircuitBreaker) State() (CircuitState, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != CircuitOpen {
+		return b.state, 0
+	}
+
+	remaining := b.settings.OpenTimeout - b.now().Sub(b.openedAt)
+	if remaining <= 0 {
+		return CircuitHalfOpen, 0
+	}
+
+	return CircuitOpen, remaining
+}
+
+// BreakerStatus is the state of the circuit of a single service.
+type BreakerStatus struct {
+	Service    string
+	State      CircuitState
+	RetryAfter time.Duration
+}
+
+// BreakerClient wraps an HttpClient with a circuit breaker for the shipping,
+// tracking and estimating services. Requests to any other url go straight through.
+type BreakerClient struct {
+	next     HttpClient
+	services []service
+	breakers map[service]*CircuitBreaker
+}
+
+func NewBreakerClient(next HttpClient, settings BreakerSettings) *BreakerClient {
+	client := &BreakerClient{
+		next:     next,
+		services: []service{shippingService, trackingService, estimatingService},
+		breakers: map[service]*CircuitBreaker{
+			shippingService:   NewCircuitBreaker("shipping", settings),
+			trackingService:   NewCircuitBreaker("tracking", settings),
+			estimatingService: NewCircuitBreaker("estimating", settings),
+		},
+	}
+
+	return client
+}
+
+func (c *BreakerClient) Do(req *http.Request) (*http.Response, error) {
+	breaker := c.breaker(req)
+	if breaker == nil {
+		return c.next.Do(req)
+	}
+
+	if err := breaker.allow(); err != nil {
+		return nil, err
+	}
+
+	response, err := c.next.Do(req)
+	if errors.Is(err, context.Canceled) {
+		// our own caller gave up, so Purolator was never checked
+		breaker.release()
+		return response, err
+	}
+
+	breaker.record(!upstreamFailure(response, err))
+
+	return response, err
+}
+
+// Health returns the state of every circuit, in a stable order.
+func (c *BreakerClient) Health() []BreakerStatus {
+	statuses := make([]BreakerStatus, 0, len(c.services))
+	for _, svc := range c.services {
+		breaker := c.breakers[svc]
+		state, retryAfter := breaker.State()
+
+		statuses = append(statuses, BreakerStatus{
+			Service:    breaker.name,
+			State:      state,
+			RetryAfter: retryAfter,
+		})
+	}
+
+	return statuses
+}
+
+func (c *BreakerClient) breaker(req *http.Request) *CircuitBreaker {
+	for _, svc := range c.services {
+		if strings.HasSuffix(req.URL.Path, svc.path) {
+			return c.breakers[svc]
+		}
+	}
+
+	return nil
+}
+
+// upstreamFailure tells if the outcome of a request means the service is not working,
+// a request rejected by Purolator does not count.
+func upstreamFailure(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	if response == nil {
+		return true
+	}
+
+	switch response.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
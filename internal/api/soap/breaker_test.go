@@ -0,0 +1,182 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+// SequenceHttpClient answers with the given status codes in order, an error for a zero status.
+type SequenceHttpClient struct {
+	statuses []int
+	calls    int
+}
+
+func (c *SequenceHttpClient) Do(req *http.Request) (*http.Response, error) {
+	status := c.statuses[min(c.calls, len(c.statuses)-1)]
+	c.calls++
+
+	if status == 0 {
+		return nil, errors.New("connection reset by peer")
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(bytes.NewReader([]byte(trackedXML))),
+	}, nil
+}
+
+func newBreakerRequest(t *testing.T, svc service) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, DefaultBaseURL+svc.path, nil)
+	if err != nil {
+		t.Fatalf("could not create the request: %v", err)
+	}
+
+	return req
+}
+
+func Test_BreakerClient(t *testing.T) {
+	settings := BreakerSettings{FailureThreshold: 2, OpenTimeout: time.Minute}
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	next := &SequenceHttpClient{statuses: []int{0, http.StatusServiceUnavailable, http.StatusOK, http.StatusOK}}
+	client := NewBreakerClient(next, settings)
+	for _, breaker := range client.breakers {
+		breaker.now = func() time.Time { return now }
+	}
+
+	do := func(svc service) error {
+		_, err := client.Do(newBreakerRequest(t, svc))
+		return err
+	}
+
+	// Two failures in a row open the shipping circuit.
+	_ = do(shippingService)
+	_ = do(shippingService)
+
+	err := do(shippingService)
+	var circuitErr *CircuitOpenError
+	if !errors.As(err, &circuitErr) || !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("BreakerClient.Do() error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	if circuitErr.RetryAfter != time.Minute {
+		t.Errorf("CircuitOpenError.RetryAfter = %v, want %v", circuitErr.RetryAfter, time.Minute)
+	}
+
+	if next.calls != 2 {
+		t.Errorf("BreakerClient.Do() sent %d requests while open, want %d", next.calls, 2)
+	}
+
+	// The other services keep their own circuit.
+	if err := do(trackingService); err != nil {
+		t.Fatalf("BreakerClient.Do() tracking error = %v, want nil", err)
+	}
+
+	want := []BreakerStatus{
+		{Service: "shipping", State: CircuitOpen, RetryAfter: time.Minute},
+		{Service: "tracking", State: CircuitClosed},
+		{Service: "estimating", State: CircuitClosed},
+	}
+	if got := client.Health(); !slices.Equal(got, want) {
+		t.Errorf("BreakerClient.Health() = %+v, want %+v", got, want)
+	}
+
+	// Once the open timeout is over a single probe goes through and closes the circuit.
+	now = now.Add(time.Minute)
+
+	if state, _ := client.breakers[shippingService].State(); state != CircuitHalfOpen {
+		t.Errorf("CircuitBreaker.State() = %v, want %v", state, CircuitHalfOpen)
+	}
+
+	if err := do(shippingService); err != nil {
+		t.Fatalf("BreakerClient.Do() probe error = %v, want nil", err)
+	}
+
+	if state, _ := client.breakers[shippingService].State(); state != CircuitClosed {
+		t.Errorf("CircuitBreaker.State() = %v, want %v", state, CircuitClosed)
+	}
+}
+
+func Test_CircuitBreaker(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	breaker := NewCircuitBreaker("tracking", BreakerSettings{FailureThreshold: 1, OpenTimeout: time.Second})
+	breaker.now = func() time.Time { return now }
+
+	if err := breaker.allow(); err != nil {
+		t.Fatalf("CircuitBreaker.allow() error = %v, want nil", err)
+	}
+	breaker.record(false)
+
+	now = now.Add(time.Second)
+
+	if err := breaker.allow(); err != nil {
+		t.Fatalf("CircuitBreaker.allow() probe error = %v, want nil", err)
+	}
+
+	// Only one probe at a time while half open.
+	if err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("CircuitBreaker.allow() error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// A failed probe opens the circuit again.
+	breaker.record(false)
+	if state, retryAfter := breaker.State(); state != CircuitOpen || retryAfter != time.Second {
+		t.Errorf("CircuitBreaker.State() = %v %v, want %v %v", state, retryAfter, CircuitOpen, time.Second)
+	}
+}
+
+func Test_BreakerClientCancelled(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	client := NewBreakerClient(&SequenceHttpClient{statuses: []int{http.StatusServiceUnavailable}}, BreakerSettings{FailureThreshold: 1, OpenTimeout: time.Minute})
+	breaker := client.breakers[trackingService]
+	breaker.now = func() time.Time { return now }
+
+	// One failure opens the circuit, once the open timeout is over the next request is the probe.
+	_, _ = client.Do(newBreakerRequest(t, trackingService))
+	now = now.Add(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A probe cancelled by our own caller says nothing about Purolator.
+	client.next = BlockingHttpClient{}
+	_, _ = client.Do(newBreakerRequest(t, trackingService).WithContext(ctx))
+
+	if state, _ := breaker.State(); state != CircuitHalfOpen {
+		t.Fatalf("CircuitBreaker.State() = %v, want %v", state, CircuitHalfOpen)
+	}
+
+	if breaker.failures != 1 {
+		t.Errorf("CircuitBreaker.failures = %d, want %d", breaker.failures, 1)
+	}
+
+	// The probe slot is free again for the next request.
+	client.next = &SequenceHttpClient{statuses: []int{http.StatusOK}}
+	if _, err := client.Do(newBreakerRequest(t, trackingService)); err != nil {
+		t.Fatalf("BreakerClient.Do() error = %v, want nil", err)
+	}
+}
+
+func Test_SoapClientCircuitOpen(t *testing.T) {
+	next := &SequenceHttpClient{statuses: []int{http.StatusServiceUnavailable}}
+	breakers := NewBreakerClient(next, BreakerSettings{FailureThreshold: 1, OpenTimeout: time.Minute})
+	client := NewSoapClient("key", "pass", breakers, WithRetryPolicy(testRetryPolicy()))
+
+	_, _ = client.TrackPackagesByPin(context.Background(), "329014521622")
+
+	_, err := client.TrackPackagesByPin(context.Background(), "329014521622")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("soap.TrackPackagesByPin() error = %v, wantErr %v", err, ErrCircuitOpen)
+	}
+
+	// The open circuit is not retried.
+	if next.calls != 1 {
+		t.Errorf("soap.TrackPackagesByPin() sent %d requests, want %d", next.calls, 1)
+	}
+}
@@ -1,11 +1,100 @@
 package soap
 
 import (
+	"context"
 	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+const (
+	getDocumentsAction = "http://purolator.com/pws/service/v1/GetDocuments"
 )
 
-func (s *SoapClient) GetDocument(trackingNO string) {
-	const op string = "hanlders.CreateShipment"
+func (s *SoapClient) GetDocuments(ctx context.Context, trackingNo, documentType, outputType string) (*models.GetDocumentsResponse, error) {
+	const op string = "soap.GetDocuments"
+
+	if len(trackingNo) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrMissingTrackingNumber)
+	}
+
+	documentsRequest := models.GetDocumentsRequest{
+		OutputType:  outputType,
+		Synchronous: true,
+		DocumentCriterium: []models.DocumentCriteria{
+			{
+				Pin:           trackingNo,
+				DocumentTypes: []string{documentType},
+			},
+		},
+	}
+
+	envelopeXML, err := NewEnvelopeXML(documentsService, s.groupID, "GetDocuments", documentsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(documentsService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(documentsService),
+		http.MethodPost,
+		getDocumentsAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetDocumentResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+// DownloadDocument fetches the content of a document URL returned by GetDocuments,
+// used when the document data was not sent inline on the soap response.
+func (s *SoapClient) DownloadDocument(ctx context.Context, url string) ([]byte, error) {
+	const op string = "soap.DownloadDocument"
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(documentsService))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w %w", op, ErrInvalidRequestURL, err)
+	}
+
+	response, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w %w", op, ErrFailedRequest, err)
+	}
+
+	if response == nil || response.Body == nil {
+		return nil, fmt.Errorf("%v: %w", op, ErrInvalidResponseBody)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%v: %w: status %d", op, ErrFailedDownload, response.StatusCode)
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w %w", op, ErrInvalidResponseBody, err)
+	}
 
-	xml.Marshal(op)
+	return data, nil
 }
@@ -1,25 +1,225 @@
 package soap
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
 	"testing"
 
 	"github.com/pesimista/purolator-rest-api/internal/api/models"
 )
 
-func Test_GetDocument(t *testing.T) {
+func Test_GetDocuments(t *testing.T) {
 	type args struct {
 		trackingNo string
 		client     HttpClient
 	}
 
+	trackingNo := "329014521622"
+
+	documentsXML := `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>ssss</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<GetDocumentsResponse>
+						<ResponseInformation>
+								<Errors/>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+						<Documents>
+								<Document>
+										<PIN>
+												<Value>` + trackingNo + `</Value>
+										</PIN>
+										<DocumentDetails>
+												<DocumentDetail>
+														<DocumentType>DomesticBillOfLading</DocumentType>
+														<Description>Domestic Bill of Lading</Description>
+														<DocumentStatus>Completed</DocumentStatus>
+														<URL>https://eshiponline.purolator.com/document.pdf</URL>
+														<Data/>
+												</DocumentDetail>
+										</DocumentDetails>
+								</Document>
+						</Documents>
+				</GetDocumentsResponse>
+		</s:Body>
+	</s:Envelope>`
+
+	errorDocumentsXML := `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>ssss</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<GetDocumentsResponse>
+						<ResponseInformation>
+								<Errors>
+										<Error>
+												<Code>1100546</Code>
+												<Description>PIN not found</Description>
+												<AdditionalInformation>Shipping Documents Error</AdditionalInformation>
+										</Error>
+								</Errors>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+						<Documents i:nil="true"/>
+				</GetDocumentsResponse>
+		</s:Body>
+	</s:Envelope>`
+
 	testCases := []struct {
 		name    string
 		args    args
-		want    *models.CreateShipmentResponse
+		want    *models.GetDocumentsResponse
 		wantErr error
-	}{}
+	}{
+		{
+			name: "When gets a valid response, return the documents",
+			args: args{
+				trackingNo: trackingNo,
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(documentsXML))),
+					},
+					err: nil,
+				},
+			},
+			want: &models.GetDocumentsResponse{
+				Documents: []models.DocumentInformation{
+					{
+						TrackingNo: trackingNo,
+						DocumentDetails: []models.DocumentDetail{
+							{
+								DocumentType:   "DomesticBillOfLading",
+								DocumentStatus: "Completed",
+								URL:            "https://eshiponline.purolator.com/document.pdf",
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "When given an error on the response, return error",
+			args: args{
+				trackingNo: trackingNo,
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(errorDocumentsXML))),
+					},
+					err: nil,
+				},
+			},
+			want:    nil,
+			wantErr: ErrSoapResponse,
+		},
+		{
+			name: "When the response is an invalid XML, return error",
+			args: args{
+				trackingNo: trackingNo,
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(invalidXML))),
+					},
+					err: nil,
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidXML,
+		},
+		{
+			name: "When the tracking number is missing, return error",
+			args: args{
+				trackingNo: "",
+				client: MockHttpClient{
+					response: &http.Response{},
+					err:      nil,
+				},
+			},
+			want:    nil,
+			wantErr: ErrMissingTrackingNumber,
+		},
+	}
 
 	for _, tt := range testCases {
-		t.Run(tt.name, func(t *testing.T) {})
+		t.Run(tt.name, func(t *testing.T) {
+			soapClient := NewSoapClient("something", "somekey", tt.args.client)
+
+			got, err := soapClient.GetDocuments(context.Background(), tt.args.trackingNo, "DomesticBillOfLading", "PDF")
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap.GetDocuments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.GetDocuments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_DownloadDocument(t *testing.T) {
+	testCases := []struct {
+		name    string
+		client  MockHttpClient
+		want    []byte
+		wantErr error
+	}{
+		{
+			name: "When the document exists, return its content",
+			client: MockHttpClient{
+				response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte("%PDF-1.4"))),
+				},
+			},
+			want:    []byte("%PDF-1.4"),
+			wantErr: nil,
+		},
+		{
+			name: "When the document url does not respond OK, return error",
+			client: MockHttpClient{
+				response: &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(bytes.NewReader([]byte("not found"))),
+				},
+			},
+			want:    nil,
+			wantErr: ErrFailedDownload,
+		},
+		{
+			name: "When the request fails, return error",
+			client: MockHttpClient{
+				response: nil,
+				err:      errors.New("failed"),
+			},
+			want:    nil,
+			wantErr: ErrFailedRequest,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			soapClient := NewSoapClient("something", "somekey", tt.client)
+
+			got, err := soapClient.DownloadDocument(context.Background(), "https://eshiponline.purolator.com/document.pdf")
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap.DownloadDocument() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.DownloadDocument() = %v, want %v", got, tt.want)
+			}
+		})
 	}
 }
@@ -0,0 +1,141 @@
+package soap
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+const (
+	soapNamespace = "http://schemas.xmlsoap.org/soap/envelope/"
+
+	defaultLanguage = "en"
+)
+
+// service describes the path, relative to the Purolator base url, and the
+// namespace and version of the datatypes used by one of the Purolator web services.
+type service struct {
+	path      string
+	namespace string
+	version   string
+}
+
+var (
+	shippingService = service{
+		path:      "/EWS/v2/Shipping/ShippingService.asmx",
+		namespace: "http://purolator.com/pws/datatypes/v2",
+		version:   "2.0",
+	}
+	estimatingService = service{
+		path:      "/EWS/V2/Estimating/EstimatingService.asmx",
+		namespace: "http://purolator.com/pws/datatypes/v2",
+		version:   "2.0",
+	}
+	serviceAvailabilityService = service{
+		path:      "/EWS/V2/ServiceAvailability/ServiceAvailabilityService.asmx",
+		namespace: "http://purolator.com/pws/datatypes/v2",
+		version:   "2.0",
+	}
+	trackingService = service{
+		path:      "/PWS/V1/Tracking/TrackingService.asmx",
+		namespace: "http://purolator.com/pws/datatypes/v1",
+		version:   "1.2",
+	}
+	pickUpService = service{
+		path:      "/EWS/V1/PickUp/PickUpService.asmx",
+		namespace: "http://purolator.com/pws/datatypes/v1",
+		version:   "1.2",
+	}
+	documentsService = service{
+		path:      "/EWS/V1/ShippingDocuments/ShippingDocumentsService.asmx",
+		namespace: "http://purolator.com/pws/datatypes/v1",
+		version:   "1.3",
+	}
+	locatorService = service{
+		path:      "/EWS/V1/Locator/LocatorService.asmx",
+		namespace: "http://purolator.com/pws/datatypes/v1",
+		version:   "1.0",
+	}
+)
+
+// Envelope is the soap envelope sent to the Purolator services.
+type Envelope struct {
+	XMLName xml.Name `xml:"soap:Envelope"`
+	Soap    string   `xml:"xmlns:soap,attr"`
+	Header  Header   `xml:"soap:Header"`
+	Body    Body     `xml:"soap:Body"`
+}
+
+// Header holds the RequestContext, on the namespace of the called service.
+type Header struct {
+	RequestContext element
+}
+
+// Body holds the request of the operation, on the namespace of the called service.
+type Body struct {
+	Request element
+}
+
+// element marshals its value as a single element with the given name,
+// ignoring whatever name the value's type or XMLName field would produce.
+type element struct {
+	name  xml.Name
+	value any
+}
+
+func (e element) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	start.Name = e.name
+	start.Attr = nil
+
+	return encoder.EncodeElement(e.value, start)
+}
+
+// NewEnvelope creates the envelope for an operation of the service,
+// the body is wrapped on an element named after the operation.
+func NewEnvelope(svc service, groupID, operation string, body any) *Envelope {
+	var (
+		Version          = svc.version
+		Language         = defaultLanguage
+		GroupID          = groupID
+		RequestReference = uuid.New().String()
+	)
+
+	header := models.RequestContext{
+		Version:          &Version,
+		Language:         &Language,
+		GroupID:          &GroupID,
+		RequestReference: &RequestReference,
+	}
+
+	return &Envelope{
+		Soap: soapNamespace,
+		Header: Header{
+			RequestContext: element{
+				name:  xml.Name{Space: svc.namespace, Local: "RequestContext"},
+				value: header,
+			},
+		},
+		Body: Body{
+			Request: element{
+				name:  xml.Name{Space: svc.namespace, Local: operation + "Request"},
+				value: body,
+			},
+		},
+	}
+}
+
+// NewEnvelopeXML creates the envelope for the operation and returns it as xml.
+func NewEnvelopeXML(svc service, groupID, operation string, body any) (string, error) {
+	op := "soap.NewEnvelopeXML"
+
+	envelope := NewEnvelope(svc, groupID, operation, body)
+
+	envelopeBuffer, err := xml.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("%v: %w %w", op, ErrInvalidRequestBody, err)
+	}
+
+	return xml.Header + string(envelopeBuffer), nil
+}
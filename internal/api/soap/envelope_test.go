@@ -0,0 +1,292 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the soap envelopes")
+
+// RecordingHttpClient keeps the body of the last request sent through it
+// and responds with an empty envelope.
+type RecordingHttpClient struct {
+	body []byte
+}
+
+func (c *RecordingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.body = body
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader([]byte("<Envelope><Body></Body></Envelope>"))),
+	}, nil
+}
+
+func loadShipmentFixture(t *testing.T) *openapi.CreateShipmentRequest {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("testdata", "create_shipment.json"))
+	if err != nil {
+		t.Fatalf("could not read the shipment fixture: %v", err)
+	}
+
+	var shipment *openapi.CreateShipmentRequest
+	if err := json.Unmarshal(content, &shipment); err != nil {
+		t.Fatalf("could not decode the shipment fixture: %v", err)
+	}
+
+	return shipment
+}
+
+func Test_EnvelopeGolden(t *testing.T) {
+	shipment := loadShipmentFixture(t)
+
+	dutyAccount := "8888888888"
+	international := loadShipmentFixture(t)
+	international.Shipment.ReceiverInformation.Address.Country = "US"
+	international.Shipment.InternationalInformation = &openapi.InternationalInformation{
+		ContentDetails: &[]openapi.ContentDetail{
+			{Description: "Books", HarmonizedCode: "490199", CountryOfManufacture: "CA", UnitValue: 1250, Quantity: 2},
+		},
+		DutyInformation: &openapi.DutyInformation{
+			BillDutiesToParty:    openapi.DutyInformationBillDutiesToPartyReceiver,
+			BusinessRelationship: openapi.NotRelated,
+			Currency:             openapi.USD,
+			AccountNumber:        &dutyAccount,
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		golden string
+		call   func(client *SoapClient) error
+	}{
+		{
+			name:   "CreateShipment",
+			golden: "create_shipment.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.CreateShipment(context.Background(), shipment)
+				return err
+			},
+		},
+		{
+			name:   "CreateShipmentInternational",
+			golden: "create_shipment_international.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.CreateShipment(context.Background(), international)
+				return err
+			},
+		},
+		{
+			name:   "ValidateShipment",
+			golden: "validate_shipment.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.ValidateShipment(context.Background(), shipment)
+				return err
+			},
+		},
+		{
+			name:   "VoidShipment",
+			golden: "void_shipment.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.VoidShipment(context.Background(), "329014521622")
+				return err
+			},
+		},
+		{
+			name:   "GetDocuments",
+			golden: "get_documents.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetDocuments(context.Background(), "329014521622", "DomesticBillOfLading", "PDF")
+				return err
+			},
+		},
+		{
+			name:   "GetQuickEstimate",
+			golden: "get_quick_estimate.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetQuickEstimate(context.Background(), newQuickRateFixture(), "9999999999")
+				return err
+			},
+		},
+		{
+			name:   "GetFullEstimate",
+			golden: "get_full_estimate.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetFullEstimate(context.Background(), newFullRateFixture(t), "9999999999")
+				return err
+			},
+		},
+		{
+			name:   "GetServicesOptions",
+			golden: "get_services_options.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetServicesOptions(
+					context.Background(),
+					"9999999999",
+					models.ShortAddress{City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W5M8"},
+					models.ShortAddress{City: "Burnaby", Province: "BC", Country: "CA", PostalCode: "V5C5A9"},
+				)
+				return err
+			},
+		},
+		{
+			name:   "GetDeliveryTimes",
+			golden: "get_delivery_times.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetDeliveryTimes(
+					context.Background(),
+					models.ShortAddress{City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W5M8"},
+					models.ShortAddress{City: "Burnaby", Province: "BC", Country: "CA", PostalCode: "V5C5A9"},
+					"2024-03-01",
+				)
+				return err
+			},
+		},
+		{
+			name:   "ValidateCityPostalCodeZip",
+			golden: "validate_city_postal_code_zip.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.ValidateCityPostalCodeZip(context.Background(), []models.ShortAddress{
+					{City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W5M8"},
+					{City: "Burnaby", Province: "BC", Country: "CA", PostalCode: "V5C5A9"},
+				})
+				return err
+			},
+		},
+		{
+			name:   "GetLocationsByCity",
+			golden: "get_locations_by_city.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetLocationsByCity(context.Background(), "Burnaby", "BC", "CA", models.LocationSearchOptions{MaxNumberofLocations: 5})
+				return err
+			},
+		},
+		{
+			name:   "GetLocationsByPostalCode",
+			golden: "get_locations_by_postal_code.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetLocationsByPostalCode(context.Background(), "V5C5A9", models.LocationSearchOptions{RadialDistanceInKM: 10, MaxNumberofLocations: 5})
+				return err
+			},
+		},
+		{
+			name:   "GetLocationsByCoordinates",
+			golden: "get_locations_by_coordinates.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetLocationsByCoordinates(context.Background(), 49.2666, -123.0046, models.LocationSearchOptions{})
+				return err
+			},
+		},
+		{
+			name:   "TrackPackagesByPin",
+			golden: "track_packages_by_pin.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.TrackPackagesByPin(context.Background(), "329014521622")
+				return err
+			},
+		},
+		{
+			name:   "TrackPackagesByReference",
+			golden: "track_packages_by_reference.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.TrackPackagesByReference(context.Background(), models.TrackPackageByReferenceSearchCriteria{
+					Reference:            "order-1234",
+					BillingAccountNumber: "9999999999",
+					ShipmentFromDate:     "2024-03-01",
+				})
+				return err
+			},
+		},
+		{
+			name:   "ValidatePickUp",
+			golden: "validate_pick_up.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.ValidatePickUp(context.Background(), newPickupFixture(t), "9999999999")
+				return err
+			},
+		},
+		{
+			name:   "SchedulePickUp",
+			golden: "schedule_pick_up.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.SchedulePickUp(context.Background(), newPickupFixture(t), "9999999999")
+				return err
+			},
+		},
+		{
+			name:   "ModifyPickUp",
+			golden: "modify_pick_up.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.ModifyPickUp(context.Background(), "01234567", &openapi.ModifyPickupRequest{UntilTime: "1800"}, "9999999999")
+				return err
+			},
+		},
+		{
+			name:   "VoidPickUp",
+			golden: "void_pick_up.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.VoidPickUp(context.Background(), "01234567")
+				return err
+			},
+		},
+		{
+			name:   "GetPickUpHistory",
+			golden: "get_pick_up_history.golden.xml",
+			call: func(client *SoapClient) error {
+				_, err := client.GetPickUpHistory(context.Background(), models.PickUpHistorySearchCriteria{
+					AccountNumber: "9999999999",
+					FromDate:      "2024-03-01",
+					ToDate:        "2024-03-31",
+				})
+				return err
+			},
+		},
+	}
+
+	uuidRegex := regexp.MustCompile("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}")
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := &RecordingHttpClient{}
+			soapClient := NewSoapClient("key", "secret", httpClient)
+
+			if err := tt.call(soapClient); err != nil {
+				t.Fatalf("soap.%s() error = %v", tt.name, err)
+			}
+
+			got := uuidRegex.ReplaceAll(httpClient.body, []byte("00000000-0000-0000-0000-000000000000"))
+			path := filepath.Join("testdata", tt.golden)
+
+			if *update {
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatalf("could not update golden file %s: %v", path, err)
+				}
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("could not read golden file %s: %v", path, err)
+			}
+
+			if !bytes.Equal(want, got) {
+				t.Fatalf("soap.%s() envelope = \n%s\nwant\n%s", tt.name, got, want)
+			}
+		})
+	}
+}
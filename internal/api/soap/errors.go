@@ -0,0 +1,90 @@
+package soap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+// Error is returned when Purolator answers with a soap:Fault, a non successful
+// HTTP status or a response carrying errors on its ResponseInformation.
+// It wraps ErrSoapResponse so it can be matched with errors.Is as well as errors.As.
+type Error struct {
+	HTTPStatus        int
+	FaultCode         string
+	FaultString       string
+	ResponseReference string
+	Errors            []models.ResponseError
+	Messages          []models.InformationalMessage
+}
+
+func (e *Error) Error() string {
+	parts := make([]string, 0, len(e.Errors)+1)
+
+	if len(e.FaultCode) > 0 || len(e.FaultString) > 0 {
+		parts = append(parts, fmt.Sprintf("fault %s: %s", e.FaultCode, e.FaultString))
+	}
+
+	for _, responseError := range e.Errors {
+		parts = append(parts, fmt.Sprintf("%s: %s", responseError.Code, responseError.Description))
+	}
+
+	if len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("http status %d", e.HTTPStatus))
+	}
+
+	return fmt.Sprintf("%s: %s", ErrSoapResponse, strings.Join(parts, "; "))
+}
+
+func (e *Error) Unwrap() error {
+	return ErrSoapResponse
+}
+
+// newResponseError returns an *Error when the ResponseInformation carries any error,
+// nil otherwise. Purolator sends those errors along an HTTP 200.
+func newResponseError(context models.RequestContext, info models.ResponseInformation) error {
+	if len(info.Errors) == 0 {
+		return nil
+	}
+
+	return &Error{
+		HTTPStatus:        http.StatusOK,
+		ResponseReference: stringValue(context.ResponseReference),
+		Errors:            info.Errors,
+		Messages:          info.InformationalMessages,
+	}
+}
+
+// newFaultError reads the soap:Fault on the body, if any, for a response
+// that failed with the given HTTP status. Purolator sends some faults with
+// an HTTP 200, so it returns nil only when the status is successful and the
+// envelope has no Fault right under its Body.
+func newFaultError(status int, body []byte) *Error {
+	var envelope models.EnvelopeFault
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		if status < http.StatusBadRequest {
+			return nil
+		}
+
+		return &Error{HTTPStatus: status}
+	}
+
+	if status < http.StatusBadRequest && envelope.Body.Fault == nil {
+		return nil
+	}
+
+	soapErr := &Error{
+		HTTPStatus:        status,
+		ResponseReference: stringValue(envelope.Header.ResponseContext.ResponseReference),
+	}
+
+	if envelope.Body.Fault != nil {
+		soapErr.FaultCode = strings.TrimSpace(envelope.Body.Fault.Code)
+		soapErr.FaultString = strings.TrimSpace(envelope.Body.Fault.String)
+	}
+
+	return soapErr
+}
@@ -0,0 +1,150 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+const faultXML = `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+	<s:Header>
+		<h:ResponseContext xmlns:h="http://purolator.com/pws/datatypes/v2">
+			<h:ResponseReference>Fault Example</h:ResponseReference>
+		</h:ResponseContext>
+	</s:Header>
+	<s:Body>
+		<s:Fault>
+			<faultcode>s:Client</faultcode>
+			<faultstring xml:lang="en-US">The message is not valid against the schema</faultstring>
+		</s:Fault>
+	</s:Body>
+</s:Envelope>`
+
+const multipleErrorsXML = `<s:Envelope>
+	<s:Header>
+		<h:ResponseContext>
+			<h:ResponseReference>Errors Example</h:ResponseReference>
+		</h:ResponseContext>
+	</s:Header>
+	<s:Body>
+		<VoidShipmentResponse>
+			<ResponseInformation>
+				<Errors>
+					<Error>
+						<Code>1100759</Code>
+						<Description>Invalid Shipment Date</Description>
+						<AdditionalInformation>Shipping Error</AdditionalInformation>
+					</Error>
+					<Error>
+						<Code>3001203</Code>
+						<Description>Invalid PIN</Description>
+					</Error>
+				</Errors>
+				<InformationalMessages>
+					<InformationalMessage>
+						<Code>100</Code>
+						<Message>Shipment already processed</Message>
+					</InformationalMessage>
+				</InformationalMessages>
+			</ResponseInformation>
+			<ShipmentVoided>false</ShipmentVoided>
+		</VoidShipmentResponse>
+	</s:Body>
+</s:Envelope>`
+
+func Test_Error(t *testing.T) {
+	testCases := []struct {
+		name   string
+		client MockHttpClient
+		want   *Error
+	}{
+		{
+			name: "When the response is a soap fault, return the fault code and string",
+			client: MockHttpClient{
+				response: &http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       io.NopCloser(bytes.NewReader([]byte(faultXML))),
+				},
+			},
+			want: &Error{
+				HTTPStatus:        http.StatusInternalServerError,
+				FaultCode:         "s:Client",
+				FaultString:       "The message is not valid against the schema",
+				ResponseReference: "Fault Example",
+			},
+		},
+		{
+			name: "When the response is a soap fault with an HTTP 200, return the fault",
+			client: MockHttpClient{
+				response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(faultXML))),
+				},
+			},
+			want: &Error{
+				HTTPStatus:        http.StatusOK,
+				FaultCode:         "s:Client",
+				FaultString:       "The message is not valid against the schema",
+				ResponseReference: "Fault Example",
+			},
+		},
+		{
+			name: "When the HTTP status is not successful and the body is not XML, return the status",
+			client: MockHttpClient{
+				response: &http.Response{
+					StatusCode: http.StatusUnauthorized,
+					Body:       io.NopCloser(bytes.NewReader([]byte("401 - Unauthorized"))),
+				},
+			},
+			want: &Error{
+				HTTPStatus: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "When the response has several errors, return all of them",
+			client: MockHttpClient{
+				response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(multipleErrorsXML))),
+				},
+			},
+			want: &Error{
+				HTTPStatus:        http.StatusOK,
+				ResponseReference: "Errors Example",
+				Errors: []models.ResponseError{
+					{Code: "1100759", Description: "Invalid Shipment Date", AdditionalInformation: "Shipping Error"},
+					{Code: "3001203", Description: "Invalid PIN"},
+				},
+				Messages: []models.InformationalMessage{
+					{Code: "100", Message: "Shipment already processed"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSoapClient("key", "secret", tt.client)
+
+			_, err := client.VoidShipment(context.Background(), "329014521622")
+			if !errors.Is(err, ErrSoapResponse) {
+				t.Fatalf("soap.VoidShipment() error = %v, wantErr %v", err, ErrSoapResponse)
+			}
+
+			var soapErr *Error
+			if !errors.As(err, &soapErr) {
+				t.Fatalf("soap.VoidShipment() error = %v, want a *soap.Error", err)
+			}
+
+			if !reflect.DeepEqual(soapErr, tt.want) {
+				t.Errorf("soap.VoidShipment() error = %+v, want %+v", soapErr, tt.want)
+			}
+		})
+	}
+}
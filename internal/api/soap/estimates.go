@@ -0,0 +1,118 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+const (
+	getQuickEstimateAction = "http://purolator.com/pws/service/v2/GetQuickEstimate"
+	getFullEstimateAction  = "http://purolator.com/pws/service/v2/GetFullEstimate"
+
+	defaultPackageType = "CustomerPackaging"
+)
+
+func (s *SoapClient) GetQuickEstimate(ctx context.Context, rate *openapi.QuickRate, billingAccount string) (*models.GetEstimateResponse, error) {
+	const op string = "soap.GetQuickEstimate"
+
+	if rate == nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	packageType := stringValue(rate.PackageType)
+	if len(packageType) == 0 {
+		packageType = defaultPackageType
+	}
+
+	estimateRequest := models.GetQuickEstimateRequest{
+		BillingAccountNumber: billingAccount,
+		SenderPostalCode:     rate.SenderPostalCode,
+		ReceiverAddress: models.ShortAddress{
+			City:       rate.ReceiverAddress.City,
+			Province:   rate.ReceiverAddress.Province,
+			Country:    rate.ReceiverAddress.Country,
+			PostalCode: rate.ReceiverAddress.PostalCode,
+		},
+		PackageType: packageType,
+		TotalWeight: s.units.weight(newWeight(rate.TotalWeight)),
+	}
+
+	envelopeXML, err := NewEnvelopeXML(estimatingService, s.groupID, "GetQuickEstimate", estimateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(estimatingService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(estimatingService),
+		http.MethodPost,
+		getQuickEstimateAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetQuickEstimateResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+func (s *SoapClient) GetFullEstimate(ctx context.Context, rate *openapi.FullRate, billingAccount string) (*models.GetEstimateResponse, error) {
+	const op string = "soap.GetFullEstimate"
+
+	if rate == nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	estimateRequest := NewFullEstimateRequest(rate, billingAccount, time.Now())
+	s.units.shipment(&estimateRequest.Shipment)
+
+	envelopeXML, err := NewEnvelopeXML(estimatingService, s.groupID, "GetFullEstimate", estimateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(estimatingService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(estimatingService),
+		http.MethodPost,
+		getFullEstimateAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetFullEstimateResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
@@ -0,0 +1,279 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+func newQuickRateFixture() *openapi.QuickRate {
+	return &openapi.QuickRate{
+		SenderPostalCode: "L4W5M8",
+		ReceiverAddress: openapi.ShortAddress{
+			City:       "Burnaby",
+			Province:   "BC",
+			Country:    "CA",
+			PostalCode: "V5C5A9",
+		},
+		TotalWeight: openapi.Weight{Value: 10, WeightUnit: openapi.Lb},
+	}
+}
+
+func newFullRateFixture(t *testing.T) *openapi.FullRate {
+	shipment := loadShipmentFixture(t).Shipment
+
+	return &openapi.FullRate{
+		SenderInformation:   shipment.SenderInformation,
+		ReceiverInformation: shipment.ReceiverInformation,
+		ShipmentDate:        &shipment.ShipmentDate,
+		PackageInformation:  shipment.PackageInformation,
+	}
+}
+
+func estimateResponseXML(operation string) string {
+	return `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>Rating Example</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<` + operation + `Response>
+						<ResponseInformation>
+								<Errors/>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+						<ShipmentEstimates>
+								<ShipmentEstimate>
+										<ServiceID>PurolatorExpress</ServiceID>
+										<ShipmentDate>2024-03-01</ShipmentDate>
+										<ExpectedDeliveryDate>2024-03-04</ExpectedDeliveryDate>
+										<EstimatedTransitDays>1</EstimatedTransitDays>
+										<BasePrice>62.35</BasePrice>
+										<Surcharges>
+												<Surcharge>
+														<Amount>7.8</Amount>
+														<Type>Fuel</Type>
+														<Description>Fuel</Description>
+												</Surcharge>
+										</Surcharges>
+										<Taxes>
+												<Tax>
+														<Amount>3.51</Amount>
+														<Type>GST</Type>
+														<Description>GST</Description>
+												</Tax>
+										</Taxes>
+										<OptionPrices i:nil="true"/>
+										<TotalPrice>73.66</TotalPrice>
+								</ShipmentEstimate>
+						</ShipmentEstimates>
+				</` + operation + `Response>
+		</s:Body>
+	</s:Envelope>`
+}
+
+func estimateErrorXML(operation string) string {
+	return `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>Rating Example</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<` + operation + `Response>
+						<ResponseInformation>
+								<Errors>
+										<Error>
+												<Code>1100315</Code>
+												<Description>Invalid Receiver Postal Code</Description>
+												<AdditionalInformation>Estimating Error</AdditionalInformation>
+										</Error>
+								</Errors>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+						<ShipmentEstimates i:nil="true"/>
+				</` + operation + `Response>
+		</s:Body>
+	</s:Envelope>`
+}
+
+var wantEstimate = &models.GetEstimateResponse{
+	ShipmentEstimates: []models.ShipmentEstimate{
+		{
+			ServiceID:            "PurolatorExpress",
+			ShipmentDate:         "2024-03-01",
+			ExpectedDeliveryDate: "2024-03-04",
+			EstimatedTransitDays: 1,
+			BasePrice:            62.35,
+			Surcharges:           []models.Charge{{Amount: 7.8, Type: "Fuel", Description: "Fuel"}},
+			Taxes:                []models.Charge{{Amount: 3.51, Type: "GST", Description: "GST"}},
+			TotalPrice:           73.66,
+		},
+	},
+}
+
+func Test_GetQuickEstimate(t *testing.T) {
+	type args struct {
+		rate   *openapi.QuickRate
+		client HttpClient
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *models.GetEstimateResponse
+		wantErr error
+	}{
+		{
+			name: "When gets a valid response, return the estimates",
+			args: args{
+				rate: newQuickRateFixture(),
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(estimateResponseXML("GetQuickEstimate")))),
+					},
+				},
+			},
+			want:    wantEstimate,
+			wantErr: nil,
+		},
+		{
+			name: "When given an error on the response, return error",
+			args: args{
+				rate: newQuickRateFixture(),
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(estimateErrorXML("GetQuickEstimate")))),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: ErrSoapResponse,
+		},
+		{
+			name: "When the response is an invalid XML, return error",
+			args: args{
+				rate: newQuickRateFixture(),
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(invalidXML))),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidXML,
+		},
+		{
+			name: "When the rate is missing, return error",
+			args: args{
+				rate:   nil,
+				client: MockHttpClient{},
+			},
+			want:    nil,
+			wantErr: ErrInvalidRequestBody,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			soapClient := NewSoapClient("something", "somekey", tt.args.client)
+
+			got, err := soapClient.GetQuickEstimate(context.Background(), tt.args.rate, "9999999999")
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap.GetQuickEstimate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.GetQuickEstimate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_GetFullEstimate(t *testing.T) {
+	type args struct {
+		rate   *openapi.FullRate
+		client HttpClient
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *models.GetEstimateResponse
+		wantErr error
+	}{
+		{
+			name: "When gets a valid response, return the estimates",
+			args: args{
+				rate: newFullRateFixture(t),
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(estimateResponseXML("GetFullEstimate")))),
+					},
+				},
+			},
+			want:    wantEstimate,
+			wantErr: nil,
+		},
+		{
+			name: "When given an error on the response, return error",
+			args: args{
+				rate: newFullRateFixture(t),
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(estimateErrorXML("GetFullEstimate")))),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: ErrSoapResponse,
+		},
+		{
+			name: "When the response is an invalid XML, return error",
+			args: args{
+				rate: newFullRateFixture(t),
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(invalidXML))),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidXML,
+		},
+		{
+			name: "When the rate is missing, return error",
+			args: args{
+				rate:   nil,
+				client: MockHttpClient{},
+			},
+			want:    nil,
+			wantErr: ErrInvalidRequestBody,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			soapClient := NewSoapClient("something", "somekey", tt.args.client)
+
+			got, err := soapClient.GetFullEstimate(context.Background(), tt.args.rate, "9999999999")
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap.GetFullEstimate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.GetFullEstimate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
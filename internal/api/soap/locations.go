@@ -0,0 +1,154 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+const (
+	getLocationsByCityAction        = "http://purolator.com/pws/service/v1/GetLocationsByCity"
+	getLocationsByPostalCodeAction  = "http://purolator.com/pws/service/v1/GetLocationsByPostalCode"
+	getLocationsByCoordinatesAction = "http://purolator.com/pws/service/v1/GetLocationsByCoordinates"
+)
+
+// GetLocationsByCity lists the Purolator locations in a city, closest to its centre first.
+func (s *SoapClient) GetLocationsByCity(ctx context.Context, city, province, country string, options models.LocationSearchOptions) (*models.GetLocationsResponse, error) {
+	const op string = "soap.GetLocationsByCity"
+
+	if len(city) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	locationsRequest := models.GetLocationsByCityRequest{
+		City:          city,
+		Province:      province,
+		Country:       country,
+		SearchOptions: options,
+	}
+
+	envelopeXML, err := NewEnvelopeXML(locatorService, s.groupID, "GetLocationsByCity", locationsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(locatorService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(locatorService),
+		http.MethodPost,
+		getLocationsByCityAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetLocationsByCityResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+// GetLocationsByPostalCode lists the Purolator locations around a postal code, closest first.
+func (s *SoapClient) GetLocationsByPostalCode(ctx context.Context, postalCode string, options models.LocationSearchOptions) (*models.GetLocationsResponse, error) {
+	const op string = "soap.GetLocationsByPostalCode"
+
+	if len(postalCode) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	locationsRequest := models.GetLocationsByPostalCodeRequest{
+		PostalCode:    postalCode,
+		SearchOptions: options,
+	}
+
+	envelopeXML, err := NewEnvelopeXML(locatorService, s.groupID, "GetLocationsByPostalCode", locationsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(locatorService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(locatorService),
+		http.MethodPost,
+		getLocationsByPostalCodeAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetLocationsByPostalCodeResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+// GetLocationsByCoordinates lists the Purolator locations around a point, closest first.
+func (s *SoapClient) GetLocationsByCoordinates(ctx context.Context, latitude, longitude float64, options models.LocationSearchOptions) (*models.GetLocationsResponse, error) {
+	const op string = "soap.GetLocationsByCoordinates"
+
+	if latitude == 0 && longitude == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	locationsRequest := models.GetLocationsByCoordinatesRequest{
+		Latitude:      latitude,
+		Longitude:     longitude,
+		SearchOptions: options,
+	}
+
+	envelopeXML, err := NewEnvelopeXML(locatorService, s.groupID, "GetLocationsByCoordinates", locationsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(locatorService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(locatorService),
+		http.MethodPost,
+		getLocationsByCoordinatesAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetLocationsByCoordinatesResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
@@ -0,0 +1,383 @@
+package soap
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+const (
+	defaultPaymentType   = "Sender"
+	defaultPickupType    = "DropOff"
+	defaultDimensionUnit = "in"
+
+	optionEnabled = "true"
+)
+
+// NewCreateShipmentRequest maps the REST request into the xml request
+// expected by the Shipping service.
+func NewCreateShipmentRequest(request *openapi.CreateShipmentRequest) *models.CreateShipmentRequest {
+	if request == nil {
+		return nil
+	}
+
+	shipment := request.Shipment
+
+	createRequest := &models.CreateShipmentRequest{
+		Shipment: models.Shipment{
+			SenderInformation: models.SenderInformation{
+				Address:   newAddress(shipment.SenderInformation.Address),
+				TaxNumber: stringValue(shipment.SenderInformation.TaxNumber),
+			},
+			ReceiverInformation: models.ReceiverInformation{
+				Address:   newAddress(shipment.ReceiverInformation.Address),
+				TaxNumber: stringValue(shipment.ReceiverInformation.TaxNumber),
+			},
+			ShipmentDate:                 shipment.ShipmentDate,
+			PackageInformation:           newPackageInformation(shipment.PackageInformation),
+			InternationalInformation:     newInternationalInformation(shipment.InternationalInformation),
+			ReturnShipmentInformation:    newReturnShipmentInformation(shipment.ReturnShipmentInformation),
+			PaymentInformation:           newPaymentInformation(shipment.PaymentInformation),
+			PickupInformation:            newPickupInformation(shipment.PickupInformation),
+			TrackingReferenceInformation: newTrackingReferenceInformation(shipment.TrackingReferenceInformation),
+		},
+		PrinterType: string(request.PrinterType),
+	}
+
+	return createRequest
+}
+
+// newInternationalInformation maps the customs data, the commercial invoice is
+// generated unless the shipment only carries documents or it was turned off.
+func newInternationalInformation(info *openapi.InternationalInformation) *models.InternationalInformation {
+	if info == nil {
+		return nil
+	}
+
+	documentsOnly := boolValue(info.DocumentsOnlyIndicator)
+
+	international := &models.InternationalInformation{
+		DocumentsOnlyIndicator:          documentsOnly,
+		ImportExportType:                stringValue(info.ImportExportType),
+		CustomsInvoiceDocumentIndicator: !documentsOnly,
+	}
+
+	if info.CustomsInvoiceDocumentIndicator != nil {
+		international.CustomsInvoiceDocumentIndicator = *info.CustomsInvoiceDocumentIndicator
+	}
+
+	if info.ContentDetails != nil {
+		for _, content := range *info.ContentDetails {
+			international.ContentDetails = append(international.ContentDetails, models.ContentDetail{
+				Description:            content.Description,
+				HarmonizedCode:         content.HarmonizedCode,
+				CountryOfManufacture:   content.CountryOfManufacture,
+				ProductCode:            stringValue(content.ProductCode),
+				UnitValue:              strconv.FormatFloat(content.UnitValue, 'f', 2, 64),
+				Quantity:               content.Quantity,
+				NAFTADocumentIndicator: boolValue(content.NaftaDocumentIndicator),
+			})
+		}
+	}
+
+	if buyer := info.BuyerInformation; buyer != nil {
+		international.BuyerInformation = &models.BuyerInformation{
+			Address:   newAddress(buyer.Address),
+			TaxNumber: stringValue(buyer.TaxNumber),
+		}
+	}
+
+	if duty := info.DutyInformation; duty != nil {
+		international.DutyInformation = &models.DutyInformation{
+			BillDutiesToParty:    string(duty.BillDutiesToParty),
+			BusinessRelationship: string(duty.BusinessRelationship),
+			Currency:             string(duty.Currency),
+			AccountNumber:        stringValue(duty.AccountNumber),
+		}
+	}
+
+	return international
+}
+
+func newReturnShipmentInformation(info *openapi.ReturnShipmentInformation) *models.ReturnShipmentInformation {
+	if info == nil {
+		return nil
+	}
+
+	shipment := info.ReturnShipment
+
+	return &models.ReturnShipmentInformation{
+		NumberOfReturnShipments: info.NumberOfReturnShipments,
+		ReturnShipment: models.ReturnShipment{
+			SenderInformation: models.SenderInformation{
+				Address:   newAddress(shipment.SenderInformation.Address),
+				TaxNumber: stringValue(shipment.SenderInformation.TaxNumber),
+			},
+			ReceiverInformation: models.ReceiverInformation{
+				Address:   newAddress(shipment.ReceiverInformation.Address),
+				TaxNumber: stringValue(shipment.ReceiverInformation.TaxNumber),
+			},
+			PackageInformation:           newPackageInformation(shipment.PackageInformation),
+			PaymentInformation:           newPaymentInformation(shipment.PaymentInformation),
+			PickupInformation:            newPickupInformation(shipment.PickupInformation),
+			TrackingReferenceInformation: newTrackingReferenceInformation(shipment.TrackingReferenceInformation),
+		},
+	}
+}
+
+func newPaymentInformation(info openapi.PaymentInformation) models.PaymentInformation {
+	paymentType := stringValue(info.PaymentType)
+	if len(paymentType) == 0 {
+		paymentType = defaultPaymentType
+	}
+
+	return models.PaymentInformation{
+		PaymentType:             paymentType,
+		RegisteredAccountNumber: stringValue(info.RegisteredAccountNumber),
+		BillingAccountNumber:    stringValue(info.BillingAccountNumber),
+	}
+}
+
+func newPickupInformation(info openapi.PickupInformation) models.PickupInformation {
+	pickupType := stringValue(info.PickupType)
+	if len(pickupType) == 0 {
+		pickupType = defaultPickupType
+	}
+
+	return models.PickupInformation{
+		PickupType: pickupType,
+	}
+}
+
+func newTrackingReferenceInformation(references *openapi.TrackingReferenceInformation) *models.TrackingReferenceInformation {
+	if references == nil {
+		return nil
+	}
+
+	return &models.TrackingReferenceInformation{
+		Reference1: stringValue(references.Reference1),
+		Reference2: stringValue(references.Reference2),
+		Reference3: stringValue(references.Reference3),
+		Reference4: stringValue(references.Reference4),
+	}
+}
+
+// NewFullEstimateRequest maps the REST full rate into the xml request expected
+// by the Estimating service, the shipment is paid by the sender and dropped off.
+func NewFullEstimateRequest(rate *openapi.FullRate, billingAccount string, now time.Time) *models.GetFullEstimateRequest {
+	if rate == nil {
+		return nil
+	}
+
+	shipmentDate := stringValue(rate.ShipmentDate)
+	if len(shipmentDate) == 0 {
+		shipmentDate = now.Format(time.DateOnly)
+	}
+
+	return &models.GetFullEstimateRequest{
+		Shipment: models.Shipment{
+			SenderInformation: models.SenderInformation{
+				Address:   newAddress(rate.SenderInformation.Address),
+				TaxNumber: stringValue(rate.SenderInformation.TaxNumber),
+			},
+			ReceiverInformation: models.ReceiverInformation{
+				Address:   newAddress(rate.ReceiverInformation.Address),
+				TaxNumber: stringValue(rate.ReceiverInformation.TaxNumber),
+			},
+			ShipmentDate:       shipmentDate,
+			PackageInformation: newPackageInformation(rate.PackageInformation),
+			PaymentInformation: models.PaymentInformation{
+				PaymentType:             defaultPaymentType,
+				RegisteredAccountNumber: billingAccount,
+				BillingAccountNumber:    billingAccount,
+			},
+			PickupInformation: models.PickupInformation{
+				PickupType: defaultPickupType,
+			},
+		},
+		ShowAlternativeServicesIndicator: true,
+	}
+}
+
+// NewPickUpRequest maps the REST pickup into the xml request shared by
+// the ValidatePickUp and SchedulePickUp operations.
+func NewPickUpRequest(pickup *openapi.PickupRequest, billingAccount string) *models.PickUpRequest {
+	if pickup == nil {
+		return nil
+	}
+
+	pickUpRequest := &models.PickUpRequest{
+		BillingAccountNumber: billingAccount,
+		PickupInstruction: models.PickupInstruction{
+			Date:                   pickup.Date,
+			AnyTimeAfter:           pickup.AnyTimeAfter,
+			UntilTime:              pickup.UntilTime,
+			TotalWeight:            newWeight(pickup.TotalWeight),
+			TotalPieces:            pickup.TotalPieces,
+			PickUpLocation:         stringValue(pickup.PickUpLocation),
+			AdditionalInstructions: stringValue(pickup.AdditionalInstructions),
+			TrailerAccessible:      boolValue(pickup.TrailerAccessible),
+			LoadingDockAvailable:   boolValue(pickup.LoadingDockAvailable),
+			ShipmentOnSkids:        int32Value(pickup.NumberOfSkids) > 0,
+			NumberOfSkids:          int32Value(pickup.NumberOfSkids),
+		},
+		Address: newAddress(pickup.Address),
+	}
+
+	if pickup.NotificationEmails != nil {
+		pickUpRequest.NotificationEmails = *pickup.NotificationEmails
+	}
+
+	return pickUpRequest
+}
+
+func newPackageInformation(info openapi.PackageInformation) models.PackageInformation {
+	packageInformation := models.PackageInformation{
+		ServiceID:   info.ServiceID,
+		Description: info.Description,
+		TotalWeight: newWeight(info.TotalWeight),
+		TotalPieces: info.TotalPieces,
+	}
+
+	if info.PiecesInformation != nil {
+		pieces := make([]models.Piece, 0, len(info.PiecesInformation.Pieces))
+		for _, piece := range info.PiecesInformation.Pieces {
+			pieces = append(pieces, newPiece(piece))
+		}
+
+		packageInformation.PiecesInformation = &models.PiecesInformation{
+			Pieces: pieces,
+		}
+	}
+
+	packageInformation.OptionsInformation = newOptionsInformation(info.OptionsInformation)
+
+	return packageInformation
+}
+
+// newOptionsInformation maps the REST options into the Purolator option ID and value pairs,
+// the options that are not set are left out.
+func newOptionsInformation(options *openapi.ShipmentOptions) *models.OptionsInformation {
+	if options == nil {
+		return nil
+	}
+
+	info := &models.OptionsInformation{}
+	add := func(id, value string) {
+		info.Options = append(info.Options, models.OptionIDValuePair{ID: id, Value: value})
+	}
+
+	flags := []struct {
+		id  string
+		set *bool
+	}{
+		{"ResidentialSignatureDomestic", options.ResidentialSignatureDomestic},
+		{"OriginSignatureNotRequired", options.OriginSignatureNotRequired},
+		{"AdultSignatureRequired", options.AdultSignatureRequired},
+		{"SaturdayDelivery", options.SaturdayDelivery},
+		{"HoldForPickup", options.HoldForPickup},
+	}
+	for _, flag := range flags {
+		if boolValue(flag.set) {
+			add(flag.id, optionEnabled)
+		}
+	}
+
+	if handling := options.SpecialHandling; handling != nil {
+		add("SpecialHandling", optionEnabled)
+		add("SpecialHandlingType", handling.Type)
+	}
+
+	if goods := options.DangerousGoods; goods != nil {
+		add("DangerousGoods", optionEnabled)
+		add("DangerousGoodsClass", string(goods.Class))
+		add("DangerousGoodsMode", string(goods.Mode))
+	}
+
+	if cheque := options.ExpressCheque; cheque != nil {
+		add("ExpressCheque", optionEnabled)
+		add("ExpressChequeMethodOfPayment", string(cheque.MethodOfPayment))
+		add("ExpressChequeAmount", strconv.FormatFloat(cheque.Amount, 'f', 2, 64))
+
+		if cheque.Address != nil {
+			address := newAddress(*cheque.Address)
+			info.ExpressChequeAddress = &address
+		}
+	}
+
+	if len(info.Options) == 0 && info.ExpressChequeAddress == nil {
+		return nil
+	}
+
+	return info
+}
+
+func newAddress(address openapi.Address) models.Address {
+	return models.Address{
+		Name:         address.Name,
+		Company:      stringValue(address.Company),
+		StreetNumber: address.StreetNumber,
+		StreetName:   address.StreetName,
+		Suite:        stringValue(address.Suite),
+		City:         address.City,
+		Province:     address.Province,
+		Country:      address.Country,
+		PostalCode:   address.PostalCode,
+		PhoneNumber: models.PhoneNumber{
+			CountryCode: stringValue(address.PhoneNumber.CountryCode),
+			AreaCode:    stringValue(address.PhoneNumber.AreaCode),
+			Phone:       stringValue(address.PhoneNumber.Phone),
+		},
+	}
+}
+
+func newPiece(piece openapi.Piece) models.Piece {
+	return models.Piece{
+		Weight: newWeight(piece.Weight),
+		Length: newDimension(piece.Length),
+		Width:  newDimension(piece.Width),
+		Height: newDimension(piece.Height),
+	}
+}
+
+func newWeight(weight openapi.Weight) models.Weight {
+	return models.Weight{
+		Value:      models.Decimal(weight.Value),
+		WeightUnit: string(weight.WeightUnit),
+	}
+}
+
+func newDimension(dimension openapi.Dimension) *models.Dimension {
+	unit := stringValue(dimension.DimensionUnit)
+	if len(unit) == 0 {
+		unit = defaultDimensionUnit
+	}
+
+	return &models.Dimension{
+		Value:         models.Decimal(dimension.Value),
+		DimensionUnit: unit,
+	}
+}
+
+// stringValue dereferences optional string values of the REST models.
+func stringValue[T ~string](value *T) string {
+	if value == nil {
+		return ""
+	}
+
+	return string(*value)
+}
+
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
+
+func int32Value(value *int32) int32 {
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
@@ -0,0 +1,196 @@
+package soap
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+func Test_NewCreateShipmentRequest(t *testing.T) {
+	fixture := loadShipmentFixture(t)
+
+	withoutDefaults := loadShipmentFixture(t)
+	withoutDefaults.Shipment.PaymentInformation.PaymentType = nil
+	withoutDefaults.Shipment.PickupInformation.PickupType = nil
+	withoutDefaults.Shipment.PackageInformation.PiecesInformation.Pieces[0].Width.DimensionUnit = nil
+
+	dimension := func(value models.Decimal) *models.Dimension {
+		return &models.Dimension{Value: value, DimensionUnit: "in"}
+	}
+
+	want := &models.CreateShipmentRequest{
+		Shipment: models.Shipment{
+			SenderInformation: models.SenderInformation{
+				Address: models.Address{
+					Name:         "Aaron Summer",
+					Company:      "Purolator Inc.",
+					StreetNumber: "5280",
+					StreetName:   "Solar Drive",
+					City:         "Mississauga",
+					Province:     "ON",
+					Country:      "CA",
+					PostalCode:   "L4W5M8",
+					PhoneNumber:  models.PhoneNumber{CountryCode: "1", AreaCode: "905", Phone: "5555555"},
+				},
+			},
+			ReceiverInformation: models.ReceiverInformation{
+				Address: models.Address{
+					Name:         "Aaron Summer",
+					StreetNumber: "2245",
+					StreetName:   "Douglas Road",
+					City:         "Burnaby",
+					Province:     "BC",
+					Country:      "CA",
+					PostalCode:   "V5C5A9",
+					PhoneNumber:  models.PhoneNumber{CountryCode: "1", AreaCode: "604", Phone: "2982181"},
+				},
+			},
+			ShipmentDate: "2024-03-01",
+			PackageInformation: models.PackageInformation{
+				ServiceID:   "PurolatorExpress",
+				Description: "Books & <magazines>",
+				TotalWeight: models.Weight{Value: 10, WeightUnit: "lb"},
+				TotalPieces: 1,
+				PiecesInformation: &models.PiecesInformation{
+					Pieces: []models.Piece{
+						{
+							Weight: models.Weight{Value: 10, WeightUnit: "lb"},
+							Length: dimension(12),
+							Width:  dimension(8),
+							Height: dimension(4),
+						},
+					},
+				},
+			},
+			PaymentInformation: models.PaymentInformation{
+				PaymentType:             "Sender",
+				RegisteredAccountNumber: "9999999999",
+				BillingAccountNumber:    "9999999999",
+			},
+			PickupInformation: models.PickupInformation{
+				PickupType: "DropOff",
+			},
+			TrackingReferenceInformation: &models.TrackingReferenceInformation{
+				Reference1: "order-1234",
+			},
+		},
+		PrinterType: "Thermal",
+	}
+
+	withReturns := loadShipmentFixture(t)
+	withReturns.Shipment.ReturnShipmentInformation = &openapi.ReturnShipmentInformation{NumberOfReturnShipments: 1}
+	withReturns.Shipment.ReturnShipmentInformation.ReturnShipment.SenderInformation = openapi.SenderInformation(withReturns.Shipment.ReceiverInformation)
+	withReturns.Shipment.ReturnShipmentInformation.ReturnShipment.ReceiverInformation = openapi.ReceiverInformation(withReturns.Shipment.SenderInformation)
+	withReturns.Shipment.ReturnShipmentInformation.ReturnShipment.PackageInformation = withReturns.Shipment.PackageInformation
+
+	wantReturns := *want
+	wantReturns.Shipment.ReturnShipmentInformation = &models.ReturnShipmentInformation{
+		NumberOfReturnShipments: 1,
+		ReturnShipment: models.ReturnShipment{
+			SenderInformation:   models.SenderInformation(want.Shipment.ReceiverInformation),
+			ReceiverInformation: models.ReceiverInformation(want.Shipment.SenderInformation),
+			PackageInformation:  want.Shipment.PackageInformation,
+			PaymentInformation:  models.PaymentInformation{PaymentType: "Sender"},
+			PickupInformation:   models.PickupInformation{PickupType: "DropOff"},
+		},
+	}
+
+	testCases := []struct {
+		name string
+		args *openapi.CreateShipmentRequest
+		want *models.CreateShipmentRequest
+	}{
+		{
+			name: "When given a complete request, map every field",
+			args: fixture,
+			want: want,
+		},
+		{
+			name: "When optional values are missing, use the defaults",
+			args: withoutDefaults,
+			want: want,
+		},
+		{
+			name: "When it asks for return labels, map the return shipment",
+			args: withReturns,
+			want: &wantReturns,
+		},
+		{
+			name: "When the request is nil, return nil",
+			args: nil,
+			want: nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewCreateShipmentRequest(tt.args)
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.NewCreateShipmentRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newOptionsInformation(t *testing.T) {
+	enabled := true
+	disabled := false
+
+	chequeAddress := openapi.Address{Name: "Aaron Summer", City: "Mississauga", Province: "ON", Country: "CA", PostalCode: "L4W5M8"}
+	wantChequeAddress := newAddress(chequeAddress)
+
+	options := &openapi.ShipmentOptions{
+		ResidentialSignatureDomestic: &enabled,
+		SaturdayDelivery:             &disabled,
+		HoldForPickup:                &enabled,
+		DangerousGoods:               &openapi.DangerousGoodsOption{Class: openapi.LimitedQuantities, Mode: openapi.Ground},
+		ExpressCheque:                &openapi.ExpressChequeOption{Amount: 125.5, MethodOfPayment: openapi.Cheque, Address: &chequeAddress},
+	}
+
+	testCases := []struct {
+		name string
+		args *openapi.ShipmentOptions
+		want *models.OptionsInformation
+	}{
+		{
+			name: "When options are set, map each one to its ID and value pairs",
+			args: options,
+			want: &models.OptionsInformation{
+				Options: []models.OptionIDValuePair{
+					{ID: "ResidentialSignatureDomestic", Value: "true"},
+					{ID: "HoldForPickup", Value: "true"},
+					{ID: "DangerousGoods", Value: "true"},
+					{ID: "DangerousGoodsClass", Value: "LimitedQuantities"},
+					{ID: "DangerousGoodsMode", Value: "Ground"},
+					{ID: "ExpressCheque", Value: "true"},
+					{ID: "ExpressChequeMethodOfPayment", Value: "Cheque"},
+					{ID: "ExpressChequeAmount", Value: "125.50"},
+				},
+				ExpressChequeAddress: &wantChequeAddress,
+			},
+		},
+		{
+			name: "When every option is disabled, return nil",
+			args: &openapi.ShipmentOptions{SaturdayDelivery: &disabled},
+			want: nil,
+		},
+		{
+			name: "When there are no options, return nil",
+			args: nil,
+			want: nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newOptionsInformation(tt.args)
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.newOptionsInformation() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
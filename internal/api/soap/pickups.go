@@ -0,0 +1,241 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+const (
+	validatePickUpAction   = "http://purolator.com/pws/service/v1/ValidatePickUp"
+	schedulePickUpAction   = "http://purolator.com/pws/service/v1/SchedulePickUp"
+	modifyPickUpAction     = "http://purolator.com/pws/service/v1/ModifyPickUp"
+	voidPickUpAction       = "http://purolator.com/pws/service/v1/VoidPickUp"
+	getPickUpHistoryAction = "http://purolator.com/pws/service/v1/GetPickUpHistory"
+)
+
+func (s *SoapClient) ValidatePickUp(ctx context.Context, pickup *openapi.PickupRequest, billingAccount string) (*models.ValidatePickUpResponse, error) {
+	const op string = "soap.ValidatePickUp"
+
+	if pickup == nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	pickUpRequest := NewPickUpRequest(pickup, billingAccount)
+	pickUpRequest.PickupInstruction.TotalWeight = s.units.weight(pickUpRequest.PickupInstruction.TotalWeight)
+
+	envelopeXML, err := NewEnvelopeXML(pickUpService, s.groupID, "ValidatePickUp", pickUpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(pickUpService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(pickUpService),
+		http.MethodPost,
+		validatePickUpAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeValidatePickUpResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+func (s *SoapClient) SchedulePickUp(ctx context.Context, pickup *openapi.PickupRequest, billingAccount string) (*models.PickUpConfirmationResponse, error) {
+	const op string = "soap.SchedulePickUp"
+
+	if pickup == nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	pickUpRequest := NewPickUpRequest(pickup, billingAccount)
+	pickUpRequest.PickupInstruction.TotalWeight = s.units.weight(pickUpRequest.PickupInstruction.TotalWeight)
+
+	envelopeXML, err := NewEnvelopeXML(pickUpService, s.groupID, "SchedulePickUp", pickUpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(pickUpService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(pickUpService),
+		http.MethodPost,
+		schedulePickUpAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeSchedulePickUpResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+func (s *SoapClient) ModifyPickUp(ctx context.Context, confirmationNo string, pickup *openapi.ModifyPickupRequest, billingAccount string) (*models.PickUpConfirmationResponse, error) {
+	const op string = "soap.ModifyPickUp"
+
+	if len(confirmationNo) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrMissingConfirmationNumber)
+	}
+
+	if pickup == nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	modifyRequest := models.ModifyPickUpRequest{
+		BillingAccountNumber: billingAccount,
+		ConfirmationNumber:   confirmationNo,
+		ModifyPickupInstruction: models.ModifyPickupInstruction{
+			UntilTime:            pickup.UntilTime,
+			PickUpLocation:       stringValue(pickup.PickUpLocation),
+			TrailerAccessible:    boolValue(pickup.TrailerAccessible),
+			LoadingDockAvailable: boolValue(pickup.LoadingDockAvailable),
+			ShipmentOnSkids:      int32Value(pickup.NumberOfSkids) > 0,
+			NumberOfSkids:        int32Value(pickup.NumberOfSkids),
+		},
+	}
+
+	envelopeXML, err := NewEnvelopeXML(pickUpService, s.groupID, "ModifyPickUp", modifyRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(pickUpService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(pickUpService),
+		http.MethodPost,
+		modifyPickUpAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeModifyPickUpResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+func (s *SoapClient) VoidPickUp(ctx context.Context, confirmationNo string) (*models.VoidPickUpResponse, error) {
+	const op string = "soap.VoidPickUp"
+
+	if len(confirmationNo) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrMissingConfirmationNumber)
+	}
+
+	voidRequest := models.VoidPickUpRequest{
+		PickUpConfirmationNumber: confirmationNo,
+	}
+
+	envelopeXML, err := NewEnvelopeXML(pickUpService, s.groupID, "VoidPickUp", voidRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(pickUpService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(pickUpService),
+		http.MethodPost,
+		voidPickUpAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeVoidPickUpResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+func (s *SoapClient) GetPickUpHistory(ctx context.Context, criteria models.PickUpHistorySearchCriteria) (*models.GetPickUpHistoryResponse, error) {
+	const op string = "soap.GetPickUpHistory"
+
+	historyRequest := models.GetPickUpHistoryRequest{
+		SearchCriteria: criteria,
+	}
+
+	envelopeXML, err := NewEnvelopeXML(pickUpService, s.groupID, "GetPickUpHistory", historyRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(pickUpService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(pickUpService),
+		http.MethodPost,
+		getPickUpHistoryAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetPickUpHistoryResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
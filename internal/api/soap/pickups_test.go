@@ -0,0 +1,234 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+func newPickupFixture(t *testing.T) *openapi.PickupRequest {
+	location := "BackDoor"
+	instructions := "Ring the bell"
+	dock := true
+	emails := []string{"warehouse@example.com"}
+
+	return &openapi.PickupRequest{
+		Date:                   "2024-03-01",
+		AnyTimeAfter:           "1200",
+		UntilTime:              "1700",
+		TotalPieces:            3,
+		TotalWeight:            openapi.Weight{Value: 25, WeightUnit: openapi.Lb},
+		PickUpLocation:         &location,
+		AdditionalInstructions: &instructions,
+		LoadingDockAvailable:   &dock,
+		Address:                loadShipmentFixture(t).Shipment.SenderInformation.Address,
+		NotificationEmails:     &emails,
+	}
+}
+
+func pickUpResponseXML(operation, content string) string {
+	return `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>PickUp Example</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<` + operation + `Response>
+						<ResponseInformation>
+								<Errors/>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+						` + content + `
+				</` + operation + `Response>
+		</s:Body>
+	</s:Envelope>`
+}
+
+func pickUpErrorXML(operation string) string {
+	return `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>PickUp Example</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<` + operation + `Response>
+						<ResponseInformation>
+								<Errors>
+										<Error>
+												<Code>1100700</Code>
+												<Description>Pickup date is invalid</Description>
+												<AdditionalInformation>PickUp Error</AdditionalInformation>
+										</Error>
+								</Errors>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+				</` + operation + `Response>
+		</s:Body>
+	</s:Envelope>`
+}
+
+func mockResponse(body string) MockHttpClient {
+	return MockHttpClient{
+		response: &http.Response{
+			Body: io.NopCloser(bytes.NewReader([]byte(body))),
+		},
+	}
+}
+
+func Test_PickUpOperations(t *testing.T) {
+	pickup := newPickupFixture(t)
+	untilTime := "1800"
+
+	testCases := []struct {
+		name    string
+		client  HttpClient
+		call    func(client *SoapClient) (any, error)
+		want    any
+		wantErr error
+	}{
+		{
+			name:   "ValidatePickUp: when gets a valid response, return it",
+			client: mockResponse(pickUpResponseXML("ValidatePickUp", `<IsBulkdRequired>false</IsBulkdRequired>`)),
+			call: func(client *SoapClient) (any, error) {
+				return client.ValidatePickUp(context.Background(), pickup, "9999999999")
+			},
+			want:    &models.ValidatePickUpResponse{IsBulkdRequired: false},
+			wantErr: nil,
+		},
+		{
+			name:   "ValidatePickUp: when given an error on the response, return error",
+			client: mockResponse(pickUpErrorXML("ValidatePickUp")),
+			call: func(client *SoapClient) (any, error) {
+				return client.ValidatePickUp(context.Background(), pickup, "9999999999")
+			},
+			want:    (*models.ValidatePickUpResponse)(nil),
+			wantErr: ErrSoapResponse,
+		},
+		{
+			name:   "SchedulePickUp: when gets a valid response, return the confirmation number",
+			client: mockResponse(pickUpResponseXML("SchedulePickUp", `<PickUpConfirmationNumber>01234567</PickUpConfirmationNumber>`)),
+			call: func(client *SoapClient) (any, error) {
+				return client.SchedulePickUp(context.Background(), pickup, "9999999999")
+			},
+			want:    &models.PickUpConfirmationResponse{PickUpConfirmationNumber: "01234567"},
+			wantErr: nil,
+		},
+		{
+			name:   "SchedulePickUp: when the pickup is missing, return error",
+			client: MockHttpClient{},
+			call: func(client *SoapClient) (any, error) {
+				return client.SchedulePickUp(context.Background(), nil, "9999999999")
+			},
+			want:    (*models.PickUpConfirmationResponse)(nil),
+			wantErr: ErrInvalidRequestBody,
+		},
+		{
+			name:   "ModifyPickUp: when gets a valid response, return the confirmation number",
+			client: mockResponse(pickUpResponseXML("ModifyPickUp", `<PickUpConfirmationNumber>01234567</PickUpConfirmationNumber>`)),
+			call: func(client *SoapClient) (any, error) {
+				return client.ModifyPickUp(context.Background(), "01234567", &openapi.ModifyPickupRequest{UntilTime: untilTime}, "9999999999")
+			},
+			want:    &models.PickUpConfirmationResponse{PickUpConfirmationNumber: "01234567"},
+			wantErr: nil,
+		},
+		{
+			name:   "ModifyPickUp: when the confirmation number is missing, return error",
+			client: MockHttpClient{},
+			call: func(client *SoapClient) (any, error) {
+				return client.ModifyPickUp(context.Background(), "", &openapi.ModifyPickupRequest{UntilTime: untilTime}, "9999999999")
+			},
+			want:    (*models.PickUpConfirmationResponse)(nil),
+			wantErr: ErrMissingConfirmationNumber,
+		},
+		{
+			name:   "VoidPickUp: when gets a valid response, return it",
+			client: mockResponse(pickUpResponseXML("VoidPickUp", `<PickUpVoided>true</PickUpVoided>`)),
+			call: func(client *SoapClient) (any, error) {
+				return client.VoidPickUp(context.Background(), "01234567")
+			},
+			want:    &models.VoidPickUpResponse{PickUpVoided: true},
+			wantErr: nil,
+		},
+		{
+			name:   "VoidPickUp: when the response is an invalid XML, return error",
+			client: mockResponse(invalidXML),
+			call: func(client *SoapClient) (any, error) {
+				return client.VoidPickUp(context.Background(), "01234567")
+			},
+			want:    (*models.VoidPickUpResponse)(nil),
+			wantErr: ErrInvalidXML,
+		},
+		{
+			name: "GetPickUpHistory: when gets a valid response, return the pickups",
+			client: mockResponse(pickUpResponseXML("GetPickUpHistory", `<PickUpHistory>
+					<PickUpDetail>
+							<BillingAccountNumber>9999999999</BillingAccountNumber>
+							<ConfirmationNumber>01234567</ConfirmationNumber>
+							<PickupStatus>Scheduled</PickupStatus>
+							<PickupDate>2024-03-01</PickupDate>
+							<AnyTimeAfter>1200</AnyTimeAfter>
+							<UntilTime>1700</UntilTime>
+							<TotalPieces>3</TotalPieces>
+							<TotalWeight>
+									<Value>25</Value>
+									<WeightUnit>lb</WeightUnit>
+							</TotalWeight>
+							<PickUpLocation>BackDoor</PickUpLocation>
+					</PickUpDetail>
+			</PickUpHistory>`)),
+			call: func(client *SoapClient) (any, error) {
+				return client.GetPickUpHistory(context.Background(), models.PickUpHistorySearchCriteria{AccountNumber: "9999999999"})
+			},
+			want: &models.GetPickUpHistoryResponse{
+				PickUps: []models.PickUpDetail{
+					{
+						BillingAccountNumber: "9999999999",
+						ConfirmationNumber:   "01234567",
+						PickupStatus:         "Scheduled",
+						PickupDate:           "2024-03-01",
+						AnyTimeAfter:         "1200",
+						UntilTime:            "1700",
+						TotalPieces:          3,
+						TotalWeight:          models.Weight{Value: 25, WeightUnit: "lb"},
+						PickUpLocation:       "BackDoor",
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "GetPickUpHistory: when given an error on the response, return error",
+			client: mockResponse(pickUpErrorXML("GetPickUpHistory")),
+			call: func(client *SoapClient) (any, error) {
+				return client.GetPickUpHistory(context.Background(), models.PickUpHistorySearchCriteria{AccountNumber: "9999999999"})
+			},
+			want:    (*models.GetPickUpHistoryResponse)(nil),
+			wantErr: ErrSoapResponse,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			soapClient := NewSoapClient("something", "somekey", tt.client)
+
+			got, err := tt.call(soapClient)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("%s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("%s = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package soap
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// retryMode tells when an operation can be sent again after a failure.
+type retryMode int
+
+const (
+	// retryBeforeSend only retries when the request never reached Purolator,
+	// for operations that would be duplicated, like creating a shipment.
+	retryBeforeSend retryMode = iota
+	// retryAlways retries any transient failure, for operations without side effects.
+	retryAlways
+)
+
+// retryModes declares how safe it is to retry each operation, by soap action.
+// Actions missing here are only retried before the request is sent.
+var retryModes = map[string]retryMode{
+	createShipmentAction:            retryBeforeSend,
+	voidShipmentAction:              retryBeforeSend,
+	validateShipmentAction:          retryAlways,
+	getDocumentsAction:              retryAlways,
+	getQuickEstimateAction:          retryAlways,
+	getFullEstimateAction:           retryAlways,
+	getServicesOptionsAction:        retryAlways,
+	validateCityPostalCodeZipAction: retryAlways,
+	getDeliveryTimesAction:          retryAlways,
+	trackPackagesByPinAction:        retryAlways,
+	trackPackagesByReferenceAction:  retryAlways,
+	validatePickUpAction:            retryAlways,
+	schedulePickUpAction:            retryBeforeSend,
+	modifyPickUpAction:              retryBeforeSend,
+	voidPickUpAction:                retryBeforeSend,
+	getPickUpHistoryAction:          retryAlways,
+	getLocationsByCityAction:        retryAlways,
+	getLocationsByPostalCodeAction:  retryAlways,
+	getLocationsByCoordinatesAction: retryAlways,
+}
+
+// RetryPolicy controls how many times, and how far apart, a failed request is sent again.
+type RetryPolicy struct {
+	// MaxAttempts counts the first request too, 1 disables the retries.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// Budget is shared by every operation of the client, a nil budget never runs out.
+	Budget *RetryBudget
+}
+
+// DefaultRetryPolicy returns the policy used by NewSoapClient.
+// Each call returns a new budget, so clients do not share it.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+		Budget:      NewRetryBudget(0.1, 10),
+	}
+}
+
+// WithRetryPolicy replaces the default retry policy of the client.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(s *SoapClient) {
+		if policy.MaxAttempts < 1 {
+			policy.MaxAttempts = 1
+		}
+
+		s.retry = policy
+	}
+}
+
+// backoff returns a random delay between zero and the exponential delay of the attempt,
+// so clients failing at the same time do not retry all together.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	return rand.N(delay)
+}
+
+// RetryBudget limits the retries to a ratio of the requests made, so a Purolator
+// outage is not made worse by every request being sent several times.
+type RetryBudget struct {
+	mu        sync.Mutex
+	ratio     float64
+	maxTokens float64
+	tokens    float64
+}
+
+// NewRetryBudget allows a retry for every 1/ratio requests, with up to maxRetries
+// saved for a burst of failures.
+func NewRetryBudget(ratio float64, maxRetries int) *RetryBudget {
+	return &RetryBudget{
+		ratio:     ratio,
+		maxTokens: float64(maxRetries),
+		tokens:    float64(maxRetries),
+	}
+}
+
+func (b *RetryBudget) deposit() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.tokens+b.ratio, b.maxTokens)
+}
+
+func (b *RetryBudget) withdraw() bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+// shouldRetry tells if the error of an attempt is worth another one.
+func shouldRetry(ctx context.Context, mode retryMode, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+
+	if notSent(err) {
+		return true
+	}
+
+	if mode != retryAlways {
+		return false
+	}
+
+	var soapErr *Error
+	if errors.As(err, &soapErr) {
+		return transientStatus(soapErr)
+	}
+
+	return errors.Is(err, ErrFailedRequest) || errors.Is(err, ErrInvalidResponseBody)
+}
+
+// notSent reports the connection failures that happened before the request was written,
+// like a refused connection or a host that could not be resolved.
+func notSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func transientStatus(soapErr *Error) bool {
+	switch soapErr.HTTPStatus {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	case http.StatusInternalServerError:
+		// A client fault will fail the same way every time.
+		return !strings.HasSuffix(soapErr.FaultCode, "Client")
+	}
+
+	return false
+}
+
+// wait sleeps for the delay unless the context is done first.
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
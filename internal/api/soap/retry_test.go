@@ -0,0 +1,242 @@
+package soap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const trackedXML = `<s:Envelope>
+	<s:Body>
+		<TrackPackagesByPinResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<TrackingInformationList>
+				<TrackingInformation>
+					<PIN><Value>329014521622</Value></PIN>
+				</TrackingInformation>
+			</TrackingInformationList>
+		</TrackPackagesByPinResponse>
+	</s:Body>
+</s:Envelope>`
+
+// failure is what the stand-in Purolator server does on a failing attempt.
+type failure func(w http.ResponseWriter)
+
+func unavailable(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusServiceUnavailable)
+}
+
+func clientFault(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusInternalServerError)
+	_, _ = w.Write([]byte(faultXML))
+}
+
+// connectionReset closes the connection after the request was received, without answering.
+func connectionReset(w http.ResponseWriter) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		return
+	}
+
+	_ = conn.Close()
+}
+
+// newFlakyServer fails the first attempts, then answers with the body.
+func newFlakyServer(t *testing.T, failures int, fail failure, body string, hits *atomic.Int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= int32(failures) {
+			fail(w)
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// CountingHttpClient counts the requests handed to the http client, even the ones never sent.
+type CountingHttpClient struct {
+	client *http.Client
+	calls  atomic.Int32
+}
+
+func (c *CountingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	c.calls.Add(1)
+	return c.client.Do(req)
+}
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+		Budget:      NewRetryBudget(0.1, 10),
+	}
+}
+
+func Test_Retry(t *testing.T) {
+	createShipment := func(ctx context.Context, client *SoapClient) error {
+		_, err := client.CreateShipment(ctx, loadShipmentFixture(t))
+		return err
+	}
+
+	trackPackages := func(ctx context.Context, client *SoapClient) error {
+		_, err := client.TrackPackagesByPin(ctx, "329014521622")
+		return err
+	}
+
+	testCases := []struct {
+		name     string
+		failures int
+		fail     failure
+		policy   RetryPolicy
+		call     func(ctx context.Context, client *SoapClient) error
+		wantHits int32
+		wantErr  error
+	}{
+		{
+			name:     "When a read fails with a 503, retry it until it works",
+			failures: 2,
+			fail:     unavailable,
+			policy:   testRetryPolicy(),
+			call:     trackPackages,
+			wantHits: 3,
+			wantErr:  nil,
+		},
+		{
+			name:     "When a read keeps failing, stop after the max attempts",
+			failures: 5,
+			fail:     unavailable,
+			policy:   testRetryPolicy(),
+			call:     trackPackages,
+			wantHits: 3,
+			wantErr:  ErrSoapResponse,
+		},
+		{
+			name:     "When the connection is reset on a read, retry it",
+			failures: 1,
+			fail:     connectionReset,
+			policy:   testRetryPolicy(),
+			call:     trackPackages,
+			wantHits: 2,
+			wantErr:  nil,
+		},
+		{
+			name:     "When a read fails with a client fault, do not retry it",
+			failures: 1,
+			fail:     clientFault,
+			policy:   testRetryPolicy(),
+			call:     trackPackages,
+			wantHits: 1,
+			wantErr:  ErrSoapResponse,
+		},
+		{
+			name:     "When a shipment fails with a 503, do not retry it",
+			failures: 1,
+			fail:     unavailable,
+			policy:   testRetryPolicy(),
+			call:     createShipment,
+			wantHits: 1,
+			wantErr:  ErrSoapResponse,
+		},
+		{
+			name:     "When the connection is reset after a shipment was sent, do not retry it",
+			failures: 1,
+			fail:     connectionReset,
+			policy:   testRetryPolicy(),
+			call:     createShipment,
+			wantHits: 1,
+			wantErr:  ErrFailedRequest,
+		},
+		{
+			name:     "When the retry budget is exhausted, do not retry",
+			failures: 1,
+			fail:     unavailable,
+			policy: RetryPolicy{
+				MaxAttempts: 3,
+				BaseDelay:   time.Millisecond,
+				MaxDelay:    5 * time.Millisecond,
+				Budget:      NewRetryBudget(0.1, 0),
+			},
+			call:     trackPackages,
+			wantHits: 1,
+			wantErr:  ErrSoapResponse,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits atomic.Int32
+			server := newFlakyServer(t, tt.failures, tt.fail, trackedXML, &hits)
+
+			client := NewSoapClient("key", "pass", server.Client(), WithBaseURL(server.URL), WithRetryPolicy(tt.policy))
+
+			err := tt.call(context.Background(), client)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap client error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := hits.Load(); got != tt.wantHits {
+				t.Errorf("soap client sent %d requests, want %d", got, tt.wantHits)
+			}
+		})
+	}
+}
+
+func Test_RetryBeforeSend(t *testing.T) {
+	// A closed server refuses the connection, so the shipment was never sent.
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	httpClient := &CountingHttpClient{client: &http.Client{}}
+	client := NewSoapClient("key", "pass", httpClient, WithBaseURL(server.URL), WithRetryPolicy(testRetryPolicy()))
+
+	_, err := client.CreateShipment(context.Background(), loadShipmentFixture(t))
+	if !errors.Is(err, ErrFailedRequest) {
+		t.Fatalf("soap.CreateShipment() error = %v, wantErr %v", err, ErrFailedRequest)
+	}
+
+	if got := httpClient.calls.Load(); got != 3 {
+		t.Errorf("soap.CreateShipment() sent %d requests, want %d", got, 3)
+	}
+}
+
+func Test_RetryBudget(t *testing.T) {
+	budget := NewRetryBudget(0.5, 1)
+
+	if !budget.withdraw() {
+		t.Fatalf("RetryBudget.withdraw() = false, want the initial retry")
+	}
+
+	if budget.withdraw() {
+		t.Fatalf("RetryBudget.withdraw() = true, want an exhausted budget")
+	}
+
+	budget.deposit()
+	budget.deposit()
+
+	if !budget.withdraw() {
+		t.Fatalf("RetryBudget.withdraw() = false, want a retry after two requests")
+	}
+}
+
+func Test_Backoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 25 * time.Millisecond}
+
+	for attempt, limit := range []time.Duration{10, 20, 25, 25} {
+		limit *= time.Millisecond
+		for range 20 {
+			if got := policy.backoff(attempt + 1); got < 0 || got >= limit {
+				t.Fatalf("RetryPolicy.backoff(%d) = %v, want less than %v", attempt+1, got, limit)
+			}
+		}
+	}
+}
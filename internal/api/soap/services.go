@@ -0,0 +1,150 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+const (
+	getServicesOptionsAction        = "http://purolator.com/pws/service/v2/GetServicesOptions"
+	validateCityPostalCodeZipAction = "http://purolator.com/pws/service/v2/ValidateCityPostalCodeZip"
+	getDeliveryTimesAction          = "http://purolator.com/pws/service/v2/GetDeliveryTimes"
+)
+
+// GetServicesOptions lists the services available between two addresses, with the options of each one.
+func (s *SoapClient) GetServicesOptions(ctx context.Context, billingAccount string, sender, receiver models.ShortAddress) (*models.GetServicesOptionsResponse, error) {
+	const op string = "soap.GetServicesOptions"
+
+	optionsRequest := models.GetServicesOptionsRequest{
+		BillingAccountNumber: billingAccount,
+		SenderAddress:        sender,
+		ReceiverAddress:      receiver,
+	}
+
+	envelopeXML, err := NewEnvelopeXML(serviceAvailabilityService, s.groupID, "GetServicesOptions", optionsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(serviceAvailabilityService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(serviceAvailabilityService),
+		http.MethodPost,
+		getServicesOptionsAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetServicesOptionsResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+// ValidateCityPostalCodeZip checks the city, province and postal code of Canadian and US
+// addresses, Purolator answers each one with its suggested address.
+func (s *SoapClient) ValidateCityPostalCodeZip(ctx context.Context, addresses []models.ShortAddress) (*models.ValidateCityPostalCodeZipResponse, error) {
+	const op string = "soap.ValidateCityPostalCodeZip"
+
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	validateRequest := models.ValidateCityPostalCodeZipRequest{
+		Addresses: addresses,
+	}
+
+	envelopeXML, err := NewEnvelopeXML(serviceAvailabilityService, s.groupID, "ValidateCityPostalCodeZip", validateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(serviceAvailabilityService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(serviceAvailabilityService),
+		http.MethodPost,
+		validateCityPostalCodeZipAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeValidateCityPostalCodeZipResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+// GetDeliveryTimes returns the expected delivery date of each service between two
+// addresses, for a shipment sent on shipmentDate.
+func (s *SoapClient) GetDeliveryTimes(ctx context.Context, sender, receiver models.ShortAddress, shipmentDate string) (*models.GetDeliveryTimesResponse, error) {
+	const op string = "soap.GetDeliveryTimes"
+
+	deliveryRequest := models.GetDeliveryTimesRequest{
+		ShipmentDetails: []models.DeliveryShipmentDetail{
+			{
+				SenderAddress:   sender,
+				ReceiverAddress: receiver,
+				ShipmentDate:    shipmentDate,
+			},
+		},
+	}
+
+	envelopeXML, err := NewEnvelopeXML(serviceAvailabilityService, s.groupID, "GetDeliveryTimes", deliveryRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(serviceAvailabilityService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(serviceAvailabilityService),
+		http.MethodPost,
+		getDeliveryTimesAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeGetDeliveryTimesResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
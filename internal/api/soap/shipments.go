@@ -1,6 +1,7 @@
 package soap
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"net/http"
@@ -10,21 +11,30 @@ import (
 )
 
 const (
-	shippingServiceURL   = "https://devwebservices.purolator.com/EWS/v2/Shipping/ShippingService.asmx"
-	createShipmentAction = "http://purolator.com/pws/service/v2/CreateShipment"
-	voidShipmentAction   = "http://purolator.com/pws/service/v2/VoidShipment"
+	createShipmentAction   = "http://purolator.com/pws/service/v2/CreateShipment"
+	voidShipmentAction     = "http://purolator.com/pws/service/v2/VoidShipment"
+	validateShipmentAction = "http://purolator.com/pws/service/v2/ValidateShipment"
 )
 
-func (s *SoapClient) CreateShipment(shipment *openapi.CreateShipmentRequest) (*models.CreateShipmentResponse, error) {
+func (s *SoapClient) CreateShipment(ctx context.Context, shipment *openapi.CreateShipmentRequest) (*models.CreateShipmentResponse, error) {
 	const op string = "soap.CreateShipment"
 
-	envelopeXML, err := NewEnvelopeXML(shipment)
+	createRequest := NewCreateShipmentRequest(shipment)
+	if createRequest != nil {
+		s.units.shipment(&createRequest.Shipment)
+	}
+
+	envelopeXML, err := NewEnvelopeXML(shippingService, s.groupID, "CreateShipment", createRequest)
 	if err != nil {
 		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(shippingService))
+	defer cancel()
+
 	responseString, err := s.HttpRequest(
-		shippingServiceURL,
+		ctx,
+		s.serviceURL(shippingService),
 		http.MethodPost,
 		createShipmentAction,
 		envelopeXML,
@@ -39,14 +49,56 @@ func (s *SoapClient) CreateShipment(shipment *openapi.CreateShipmentRequest) (*m
 		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
 	}
 
-	if response.Body.Error != nil {
-		return nil, fmt.Errorf("%s: %w %v", op, ErrSoapResponse, response.Body.Error.Description)
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return &response.Body, nil
 }
 
-func (s *SoapClient) VoidShipment(trackingNo string) (*models.VoidShipmentResponse, error) {
+// ValidateShipment checks the shipment against the Purolator rules without creating it,
+// the validation errors are part of the response instead of being returned as an error.
+func (s *SoapClient) ValidateShipment(ctx context.Context, shipment *openapi.CreateShipmentRequest) (*models.ValidateShipmentResponse, error) {
+	const op string = "soap.ValidateShipment"
+
+	if shipment == nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequestBody)
+	}
+
+	validateRequest := models.ValidateShipmentRequest{
+		Shipment: NewCreateShipmentRequest(shipment).Shipment,
+	}
+	s.units.shipment(&validateRequest.Shipment)
+
+	envelopeXML, err := NewEnvelopeXML(shippingService, s.groupID, "ValidateShipment", validateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(shippingService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(shippingService),
+		http.MethodPost,
+		validateShipmentAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeValidateShipmentResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	return &response.Body, nil
+}
+
+func (s *SoapClient) VoidShipment(ctx context.Context, trackingNo string) (*models.VoidShipmentResponse, error) {
 	const op string = "soap.VoidShipment"
 
 	if len(trackingNo) == 0 {
@@ -57,20 +109,23 @@ func (s *SoapClient) VoidShipment(trackingNo string) (*models.VoidShipmentRespon
 		Pin: trackingNo,
 	}
 
-	envelopeXML, err := NewEnvelopeXML(voidRequest)
+	envelopeXML, err := NewEnvelopeXML(shippingService, s.groupID, "VoidShipment", voidRequest)
 	if err != nil {
 		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(shippingService))
+	defer cancel()
+
 	responseString, err := s.HttpRequest(
-		shippingServiceURL,
+		ctx,
+		s.serviceURL(shippingService),
 		http.MethodPost,
 		voidShipmentAction,
 		envelopeXML,
 	)
-
 	if err != nil {
-		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+		return nil, fmt.Errorf("%v: %w", op, err)
 	}
 
 	var response *models.EnvelopeVoidShipmentResponse
@@ -79,8 +134,8 @@ func (s *SoapClient) VoidShipment(trackingNo string) (*models.VoidShipmentRespon
 		return nil, fmt.Errorf("%s: %w %s", op, ErrInvalidXML, err)
 	}
 
-	if response.Body.Error != nil {
-		return nil, fmt.Errorf("%s: %w %v", op, ErrSoapResponse, response.Body.Error.Description)
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return &response.Body, nil
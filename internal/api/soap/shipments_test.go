@@ -2,6 +2,7 @@ package soap
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
@@ -154,7 +155,7 @@ func Test_CreateShipment(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			soapClient := NewSoapClient("something", "somekey", tt.args.client)
 
-			got, err := soapClient.CreateShipment(tt.args.shipment)
+			got, err := soapClient.CreateShipment(context.Background(), tt.args.shipment)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Fatalf("soap.CreateShipment() error = %v, wantErr %v", err, tt.wantErr)
@@ -282,7 +283,7 @@ func Test_VoidShipment(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			soapClient := NewSoapClient("client", "secret", tt.args.client)
 
-			got, err := soapClient.VoidShipment(tt.args.trackingNo)
+			got, err := soapClient.VoidShipment(context.Background(), tt.args.trackingNo)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Fatalf("soap.VoidShipment() error = %v, wantErr %v", err, tt.wantErr)
@@ -294,3 +295,137 @@ func Test_VoidShipment(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateShipment(t *testing.T) {
+	type args struct {
+		shipment *openapi.CreateShipmentRequest
+		client   HttpClient
+	}
+
+	validXML := `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>ssss</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<ValidateShipmentResponse>
+						<ResponseInformation>
+								<Errors/>
+								<InformationalMessages>
+										<InformationalMessage>
+												<Code>1100999</Code>
+												<Message>Residential surcharge applies</Message>
+										</InformationalMessage>
+								</InformationalMessages>
+						</ResponseInformation>
+						<ValidShipment>true</ValidShipment>
+				</ValidateShipmentResponse>
+		</s:Body>
+	</s:Envelope>`
+
+	invalidShipmentXML := `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>ssss</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<ValidateShipmentResponse>
+						<ResponseInformation>
+								<Errors>
+										<Error>
+												<Code>1100759</Code>
+												<Description>Invalid Shipment Date</Description>
+												<AdditionalInformation>Shipping Error</AdditionalInformation>
+										</Error>
+										<Error>
+												<Code>1100315</Code>
+												<Description>Invalid Receiver Postal Code</Description>
+												<AdditionalInformation/>
+										</Error>
+								</Errors>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+						<ValidShipment>false</ValidShipment>
+				</ValidateShipmentResponse>
+		</s:Body>
+	</s:Envelope>`
+
+	shipment := loadShipmentFixture(t)
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *models.ValidateShipmentResponse
+		wantErr error
+	}{
+		{
+			name: "When the shipment is valid, return the informational messages",
+			args: args{
+				shipment: shipment,
+				client:   mockResponse(validXML),
+			},
+			want: &models.ValidateShipmentResponse{
+				ResponseInformation: models.ResponseInformation{
+					InformationalMessages: []models.InformationalMessage{
+						{Code: "1100999", Message: "Residential surcharge applies"},
+					},
+				},
+				ValidShipment: true,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "When the shipment is invalid, return every validation error without failing",
+			args: args{
+				shipment: shipment,
+				client:   mockResponse(invalidShipmentXML),
+			},
+			want: &models.ValidateShipmentResponse{
+				ResponseInformation: models.ResponseInformation{
+					Errors: []models.ResponseError{
+						{Code: "1100759", Description: "Invalid Shipment Date", AdditionalInformation: "Shipping Error"},
+						{Code: "1100315", Description: "Invalid Receiver Postal Code"},
+					},
+				},
+				ValidShipment: false,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "When the response is an invalid XML, return error",
+			args: args{
+				shipment: shipment,
+				client:   mockResponse(invalidXML),
+			},
+			want:    nil,
+			wantErr: ErrInvalidXML,
+		},
+		{
+			name: "When the shipment is missing, return error",
+			args: args{
+				shipment: nil,
+				client:   MockHttpClient{},
+			},
+			want:    nil,
+			wantErr: ErrInvalidRequestBody,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			soapClient := NewSoapClient("client", "secret", tt.args.client)
+
+			got, err := soapClient.ValidateShipment(context.Background(), tt.args.shipment)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap.ValidateShipment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.ValidateShipment() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,54 +2,172 @@ package soap
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
-	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"regexp"
 	"strings"
-
-	"github.com/google/uuid"
-	"github.com/pesimista/purolator-rest-api/internal/api/models"
-)
-
-const (
-	bodyPrefix   = "q2"
-	headerPrefix = "soap"
+	"time"
 )
 
 var (
-	ErrMissingTrackingNumber = errors.New("missing tracking number")
-	ErrInvalidRequestURL     = errors.New("invalid request url")
-	ErrInvalidRequestBody    = errors.New("invalid request body")
-	ErrFailedRequest         = errors.New("error making http request")
-	ErrInvalidResponseBody   = errors.New("could not read response body")
-	ErrInvalidXML            = errors.New("could not decode xml body")
-	ErrSoapResponse          = errors.New("error on soap response")
+	ErrMissingTrackingNumber     = errors.New("missing tracking number")
+	ErrMissingReference          = errors.New("missing reference")
+	ErrMissingConfirmationNumber = errors.New("missing pickup confirmation number")
+	ErrInvalidRequestURL         = errors.New("invalid request url")
+	ErrInvalidRequestBody        = errors.New("invalid request body")
+	ErrFailedRequest             = errors.New("error making http request")
+	ErrInvalidResponseBody       = errors.New("could not read response body")
+	ErrInvalidXML                = errors.New("could not decode xml body")
+	ErrSoapResponse              = errors.New("error on soap response")
+	ErrFailedDownload            = errors.New("could not download document")
+	ErrCircuitOpen               = errors.New("circuit open for the purolator service")
 )
 
 type HttpClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+const (
+	DefaultBaseURL = "https://devwebservices.purolator.com"
+	DefaultGroupID = "234521"
+)
+
+// Timeouts bounds how long each kind of operation waits for Purolator,
+// a zero value keeps the default one.
+type Timeouts struct {
+	Shipping   time.Duration
+	Documents  time.Duration
+	Estimating time.Duration
+	Tracking   time.Duration
+	PickUp     time.Duration
+}
+
+var DefaultTimeouts = Timeouts{
+	Shipping:   30 * time.Second,
+	Documents:  30 * time.Second,
+	Estimating: 15 * time.Second,
+	Tracking:   10 * time.Second,
+	PickUp:     15 * time.Second,
+}
+
 type SoapClient struct {
 	token      string
+	baseURL    string
+	groupID    string
+	timeouts   Timeouts
+	retry      RetryPolicy
+	units      Units
 	httpClient HttpClient
 }
 
-func NewSoapClient(appKey, appSecret string, httpClient HttpClient) *SoapClient {
-	return &SoapClient{
+// Option customizes the SoapClient created by NewSoapClient.
+type Option func(*SoapClient)
+
+// WithBaseURL sets the Purolator base url, like the development or the production one.
+func WithBaseURL(baseURL string) Option {
+	return func(s *SoapClient) {
+		s.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithGroupID sets the GroupID sent on the RequestContext of every request.
+func WithGroupID(groupID string) Option {
+	return func(s *SoapClient) {
+		s.groupID = groupID
+	}
+}
+
+// WithTimeouts overrides the default timeout of each kind of operation.
+func WithTimeouts(timeouts Timeouts) Option {
+	return func(s *SoapClient) {
+		setDuration(&s.timeouts.Shipping, timeouts.Shipping)
+		setDuration(&s.timeouts.Documents, timeouts.Documents)
+		setDuration(&s.timeouts.Estimating, timeouts.Estimating)
+		setDuration(&s.timeouts.Tracking, timeouts.Tracking)
+		setDuration(&s.timeouts.PickUp, timeouts.PickUp)
+	}
+}
+
+func setDuration(field *time.Duration, value time.Duration) {
+	if value > 0 {
+		*field = value
+	}
+}
+
+func NewSoapClient(appKey, appSecret string, httpClient HttpClient, opts ...Option) *SoapClient {
+	client := &SoapClient{
 		token:      base64.StdEncoding.EncodeToString([]byte(appKey + ":" + appSecret)),
+		baseURL:    DefaultBaseURL,
+		groupID:    DefaultGroupID,
+		timeouts:   DefaultTimeouts,
+		retry:      DefaultRetryPolicy(),
+		units:      DefaultUnits,
 		httpClient: httpClient,
 	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+func (s SoapClient) serviceURL(svc service) string {
+	return s.baseURL + svc.path
+}
+
+// Timeouts returns the timeout of each kind of operation, defaults included.
+func (s SoapClient) Timeouts() Timeouts {
+	return s.timeouts
+}
+
+// timeout returns the time an operation on the given service is allowed to take.
+func (s SoapClient) timeout(svc service) time.Duration {
+	switch svc {
+	case shippingService:
+		return s.timeouts.Shipping
+	case documentsService:
+		return s.timeouts.Documents
+	case estimatingService, serviceAvailabilityService, locatorService:
+		return s.timeouts.Estimating
+	case trackingService:
+		return s.timeouts.Tracking
+	case pickUpService:
+		return s.timeouts.PickUp
+	}
+
+	return s.timeouts.Shipping
+}
+
+// HttpRequest sends the soap request, retrying it as allowed by the retry policy
+// and the retry mode of the soap action.
+func (s SoapClient) HttpRequest(ctx context.Context, url, method, soapAction, body string) (string, error) {
+	mode := retryModes[soapAction]
+	s.retry.Budget.deposit()
+
+	for attempt := 1; ; attempt++ {
+		response, err := s.attempt(ctx, url, method, soapAction, body)
+		if err == nil || attempt >= s.retry.MaxAttempts || !shouldRetry(ctx, mode, err) {
+			return response, err
+		}
+
+		if !s.retry.Budget.withdraw() {
+			return response, err
+		}
+
+		if waitErr := wait(ctx, s.retry.backoff(attempt)); waitErr != nil {
+			return response, err
+		}
+	}
 }
 
-func (s SoapClient) HttpRequest(url, method, soapAction, body string) (string, error) {
+func (s SoapClient) attempt(ctx context.Context, url, method, soapAction, body string) (string, error) {
 	op := "soap.HttpRequest"
 
-	req, err := http.NewRequest(method, url, bytes.NewReader([]byte(body)))
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader([]byte(body)))
 	if err != nil {
 		return "", fmt.Errorf("%v: %w %w", op, ErrInvalidRequestURL, err)
 	}
@@ -67,60 +185,16 @@ func (s SoapClient) HttpRequest(url, method, soapAction, body string) (string, e
 		return "", fmt.Errorf("%v: %w", op, ErrInvalidResponseBody)
 	}
 
+	defer response.Body.Close()
+
 	resBody, err := io.ReadAll(response.Body)
 	if err != nil {
 		return "", fmt.Errorf("%v: %w %w", op, ErrInvalidResponseBody, err)
 	}
 
-	return string(resBody), nil
-}
-
-func NewEnvelopeXML(body any) (string, error) {
-	op := "soap.NewEnvelopeXML"
-
-	envelope := models.NewEnvelope("REPLACED_BY_HEADER", "REPLACED_BY_BODY")
-
-	envelopeBuffer, err := xml.MarshalIndent(envelope, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("%v: %w %w", op, ErrInvalidRequestBody, err)
-	}
-
-	envelopeXML := addPrefix(string(envelopeBuffer), headerPrefix)
-
-	var (
-		Version          = "2.0"
-		Language         = "en"
-		GroupID          = "234521"
-		RequestReference = uuid.New().String()
-	)
-
-	header := models.RequestContext{
-		Version:          &Version,
-		Language:         &Language,
-		GroupID:          &GroupID,
-		RequestReference: &RequestReference,
-	}
-
-	headerBuffer, err := xml.MarshalIndent(header, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("%v: %w %w", op, ErrInvalidRequestBody, err)
+	if soapErr := newFaultError(response.StatusCode, resBody); soapErr != nil {
+		return "", fmt.Errorf("%v: %w", op, soapErr)
 	}
-	headerXML := addPrefix(string(headerBuffer), bodyPrefix)
 
-	bodyBuffer, err := xml.MarshalIndent(body, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("%v: %w %w", op, ErrInvalidRequestBody, err)
-	}
-	bodyXML := addPrefix(string(bodyBuffer), bodyPrefix)
-
-	envelopeXML = strings.ReplaceAll(envelopeXML, "REPLACED_BY_HEADER", fmt.Sprintf("\n%s\n", headerXML))
-	envelopeXML = strings.ReplaceAll(envelopeXML, "REPLACED_BY_BODY", fmt.Sprintf("\n%s\n", bodyXML))
-
-	return envelopeXML, nil
-}
-
-func addPrefix(data, prefix string) string {
-	regex := regexp.MustCompile(`(<\/?)`)
-
-	return regex.ReplaceAllString(data, fmt.Sprintf("${1}%s:", prefix))
+	return string(resBody), nil
 }
@@ -2,16 +2,20 @@ package soap
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/go-faker/faker/v4"
 )
 
+const testServiceURL = DefaultBaseURL + "/EWS/v2/Shipping/ShippingService.asmx"
+
 type MockHttpClient struct {
 	response *http.Response
 	err      error
@@ -21,6 +25,19 @@ func (c MockHttpClient) Do(req *http.Request) (*http.Response, error) {
 	return c.response, c.err
 }
 
+// notFaultXML is a successful response with an element whose name ends like a fault.
+const notFaultXML = `<s:Envelope>
+	<s:Body>
+		<VoidShipmentResponse>
+			<ResponseInformation>
+				<Errors/>
+			</ResponseInformation>
+			<ShipmentVoided>true</ShipmentVoided>
+			<Notes><DeliveryFault>none</DeliveryFault></Notes>
+		</VoidShipmentResponse>
+	</s:Body>
+</s:Envelope>`
+
 func Test_HttpRequest(t *testing.T) {
 	type args struct {
 		url        string
@@ -43,7 +60,7 @@ func Test_HttpRequest(t *testing.T) {
 				err:      nil,
 			},
 			args: args{
-				url:        shippingServiceURL,
+				url:        testServiceURL,
 				method:     http.MethodPost,
 				soapAction: createShipmentAction,
 				body:       "<soap:Envelope></soap:Envelope>",
@@ -51,6 +68,39 @@ func Test_HttpRequest(t *testing.T) {
 			want:    "<Envelope></Envelope>",
 			wantErr: nil,
 		},
+		{
+			name: "When the response has an element named like a fault, return response value",
+			client: MockHttpClient{
+				response: &http.Response{Body: io.NopCloser(bytes.NewReader([]byte(notFaultXML)))},
+				err:      nil,
+			},
+			args: args{
+				url:        testServiceURL,
+				method:     http.MethodPost,
+				soapAction: createShipmentAction,
+				body:       "<soap:Envelope></soap:Envelope>",
+			},
+			want:    notFaultXML,
+			wantErr: nil,
+		},
+		{
+			name: "When the response is a soap fault with an HTTP 200, return error",
+			client: MockHttpClient{
+				response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader([]byte(faultXML))),
+				},
+				err: nil,
+			},
+			args: args{
+				url:        testServiceURL,
+				method:     http.MethodPost,
+				soapAction: createShipmentAction,
+				body:       "<soap:Envelope></soap:Envelope>",
+			},
+			want:    "",
+			wantErr: ErrSoapResponse,
+		},
 		{
 			name: "When called with invalid url, return error",
 			client: MockHttpClient{
@@ -73,7 +123,7 @@ func Test_HttpRequest(t *testing.T) {
 				err:      nil,
 			},
 			args: args{
-				url:        shippingServiceURL,
+				url:        testServiceURL,
 				method:     http.MethodPost,
 				soapAction: createShipmentAction,
 				body:       "<soap:Envelope></soap:Envelope>",
@@ -90,7 +140,7 @@ func Test_HttpRequest(t *testing.T) {
 				err: fmt.Errorf("failed"),
 			},
 			args: args{
-				url:        shippingServiceURL,
+				url:        testServiceURL,
 				method:     http.MethodPost,
 				soapAction: createShipmentAction,
 				body:       "<soap:Envelope></soap:Envelope>",
@@ -104,7 +154,7 @@ func Test_HttpRequest(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			soapClient := NewSoapClient("key", "pass", tt.client)
 
-			got, err := soapClient.HttpRequest(tt.args.url, tt.args.method, tt.args.soapAction, tt.args.body)
+			got, err := soapClient.HttpRequest(context.Background(), tt.args.url, tt.args.method, tt.args.soapAction, tt.args.body)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Fatalf("soap.HttpRequest() error = %v, wantErr %v", err, tt.wantErr)
@@ -125,31 +175,63 @@ func Test_NewEnvelopeXML(t *testing.T) {
 	pin := faker.UUIDDigit()
 
 	testCases := []struct {
-		name    string
-		args    MockXMLBody
-		want    string
-		wantErr error
+		name      string
+		svc       service
+		operation string
+		args      MockXMLBody
+		want      string
+		wantErr   error
 	}{
 		{
-			name: "When called with valid body, return xml as string",
+			name:      "When called with valid body, return xml as string",
+			svc:       shippingService,
+			operation: "VoidShipment",
 			args: MockXMLBody{
 				Pin: pin,
 			},
-			want: `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:q2="http://purolator.com/pws/datatypes/v2">` +
+			want: `<?xml version="1.0" encoding="UTF-8"?>` +
+				`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">` +
 				`<soap:Header>` +
-				`<q2:RequestContext>` +
-				`<q2:Version>2.0</q2:Version>` +
-				`<q2:Language>en</q2:Language>` +
-				`<q2:GroupID>234521</q2:GroupID>` +
-				`<q2:RequestReference>uuid</q2:RequestReference>` +
-				`</q2:RequestContext>` +
+				`<RequestContext xmlns="http://purolator.com/pws/datatypes/v2">` +
+				`<Version>2.0</Version>` +
+				`<Language>en</Language>` +
+				`<GroupID>234521</GroupID>` +
+				`<RequestReference>uuid</RequestReference>` +
+				`</RequestContext>` +
 				`</soap:Header>` +
 				`<soap:Body>` +
-				`<q2:MockXMLBody>` +
-				`<q2:PIN>` +
-				`<q2:Value>` + pin + `</q2:Value>` +
-				`</q2:PIN>` +
-				`</q2:MockXMLBody>` +
+				`<VoidShipmentRequest xmlns="http://purolator.com/pws/datatypes/v2">` +
+				`<PIN>` +
+				`<Value>` + pin + `</Value>` +
+				`</PIN>` +
+				`</VoidShipmentRequest>` +
+				`</soap:Body>` +
+				`</soap:Envelope>`,
+			wantErr: nil,
+		},
+		{
+			name:      "When a value contains xml characters, return them escaped",
+			svc:       documentsService,
+			operation: "GetDocuments",
+			args: MockXMLBody{
+				Pin: "<a></a>&",
+			},
+			want: `<?xml version="1.0" encoding="UTF-8"?>` +
+				`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">` +
+				`<soap:Header>` +
+				`<RequestContext xmlns="http://purolator.com/pws/datatypes/v1">` +
+				`<Version>1.3</Version>` +
+				`<Language>en</Language>` +
+				`<GroupID>234521</GroupID>` +
+				`<RequestReference>uuid</RequestReference>` +
+				`</RequestContext>` +
+				`</soap:Header>` +
+				`<soap:Body>` +
+				`<GetDocumentsRequest xmlns="http://purolator.com/pws/datatypes/v1">` +
+				`<PIN>` +
+				`<Value>&lt;a&gt;&lt;/a&gt;&amp;</Value>` +
+				`</PIN>` +
+				`</GetDocumentsRequest>` +
 				`</soap:Body>` +
 				`</soap:Envelope>`,
 			wantErr: nil,
@@ -158,7 +240,7 @@ func Test_NewEnvelopeXML(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewEnvelopeXML(tt.args)
+			got, err := NewEnvelopeXML(tt.svc, DefaultGroupID, tt.operation, tt.args)
 			uuidRegex := regexp.MustCompile("[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}")
 			regex := regexp.MustCompile(`\n\s*`)
 
@@ -174,3 +256,84 @@ func Test_NewEnvelopeXML(t *testing.T) {
 		})
 	}
 }
+
+// BlockingHttpClient waits until the request context is done, like a Purolator endpoint that never answers.
+type BlockingHttpClient struct{}
+
+func (c BlockingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func Test_Timeouts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		ctx     func() (context.Context, context.CancelFunc)
+		call    func(ctx context.Context, client *SoapClient) error
+		wantErr error
+	}{
+		{
+			name: "When the operation takes longer than its timeout, return error",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			call: func(ctx context.Context, client *SoapClient) error {
+				_, err := client.TrackPackagesByPin(ctx, "329014521622")
+				return err
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+		{
+			name: "When the caller context is cancelled, return error",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			call: func(ctx context.Context, client *SoapClient) error {
+				_, err := client.CreateShipment(ctx, loadShipmentFixture(t))
+				return err
+			},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "When a document download takes longer than its timeout, return error",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			call: func(ctx context.Context, client *SoapClient) error {
+				_, err := client.DownloadDocument(ctx, "https://eshiponline.purolator.com/document.pdf")
+				return err
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSoapClient("key", "pass", BlockingHttpClient{}, WithTimeouts(Timeouts{
+				Tracking:  10 * time.Millisecond,
+				Documents: 10 * time.Millisecond,
+			}))
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			err := tt.call(ctx, client)
+			if !errors.Is(err, ErrFailedRequest) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap client error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_WithTimeouts(t *testing.T) {
+	client := NewSoapClient("key", "pass", MockHttpClient{}, WithTimeouts(Timeouts{Tracking: time.Second}))
+
+	want := DefaultTimeouts
+	want.Tracking = time.Second
+
+	if client.timeouts != want {
+		t.Fatalf("soap.WithTimeouts() = %+v, want %+v", client.timeouts, want)
+	}
+}
@@ -0,0 +1,101 @@
+package soap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+const (
+	trackPackagesByPinAction       = "http://purolator.com/pws/service/v1/TrackPackagesByPin"
+	trackPackagesByReferenceAction = "http://purolator.com/pws/service/v1/TrackPackagesByReference"
+)
+
+func (s *SoapClient) TrackPackagesByPin(ctx context.Context, trackingNo string) (*models.TrackPackagesResponse, error) {
+	const op string = "soap.TrackPackagesByPin"
+
+	if len(trackingNo) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrMissingTrackingNumber)
+	}
+
+	trackRequest := models.TrackPackagesByPinRequest{
+		PINs: []models.PIN{{Value: trackingNo}},
+	}
+
+	envelopeXML, err := NewEnvelopeXML(trackingService, s.groupID, "TrackPackagesByPin", trackRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(trackingService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(trackingService),
+		http.MethodPost,
+		trackPackagesByPinAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeTrackPackagesByPinResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
+
+func (s *SoapClient) TrackPackagesByReference(ctx context.Context, criteria models.TrackPackageByReferenceSearchCriteria) (*models.TrackPackagesResponse, error) {
+	const op string = "soap.TrackPackagesByReference"
+
+	if len(criteria.Reference) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ErrMissingReference)
+	}
+
+	trackRequest := models.TrackPackagesByReferenceRequest{
+		SearchCriteria: criteria,
+	}
+
+	envelopeXML, err := NewEnvelopeXML(trackingService, s.groupID, "TrackPackagesByReference", trackRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: could create an envelope for the request: %s", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout(trackingService))
+	defer cancel()
+
+	responseString, err := s.HttpRequest(
+		ctx,
+		s.serviceURL(trackingService),
+		http.MethodPost,
+		trackPackagesByReferenceAction,
+		envelopeXML,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", op, err)
+	}
+
+	var response *models.EnvelopeTrackPackagesByReferenceResponse
+	err = xml.Unmarshal([]byte(responseString), &response)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidXML, err)
+	}
+
+	if err := newResponseError(response.Header.ResponseContext, response.Body.ResponseInformation); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &response.Body, nil
+}
@@ -0,0 +1,247 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+func trackingResponseXML(operation string) string {
+	return `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>Tracking Example</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<` + operation + `Response>
+						<ResponseInformation>
+								<Errors/>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+						<TrackingInformationList>
+								<TrackingInformation>
+										<PIN>
+												<Value>329014521622</Value>
+										</PIN>
+										<Scans>
+												<Scan i:type="DeliveryScan">
+														<ScanType>Delivery</ScanType>
+														<PIN>
+																<Value>329014521622</Value>
+														</PIN>
+														<Depot>
+																<Name>VANCOUVER</Name>
+														</Depot>
+														<ScanDate>2024-03-04</ScanDate>
+														<ScanTime>101500</ScanTime>
+														<Description>Shipment delivered to</Description>
+														<Comment>FRONT DOOR</Comment>
+														<SummaryScanIndicator>true</SummaryScanIndicator>
+												</Scan>
+										</Scans>
+								</TrackingInformation>
+						</TrackingInformationList>
+				</` + operation + `Response>
+		</s:Body>
+	</s:Envelope>`
+}
+
+func trackingErrorXML(operation string) string {
+	return `<s:Envelope>
+		<s:Header>
+				<h:ResponseContext>
+						<h:ResponseReference>Tracking Example</h:ResponseReference>
+				</h:ResponseContext>
+		</s:Header>
+		<s:Body>
+				<` + operation + `Response>
+						<ResponseInformation>
+								<Errors>
+										<Error>
+												<Code>3001</Code>
+												<Description>No shipment found</Description>
+												<AdditionalInformation>Tracking Error</AdditionalInformation>
+										</Error>
+								</Errors>
+								<InformationalMessages i:nil="true"/>
+						</ResponseInformation>
+						<TrackingInformationList i:nil="true"/>
+				</` + operation + `Response>
+		</s:Body>
+	</s:Envelope>`
+}
+
+var wantTracking = &models.TrackPackagesResponse{
+	TrackingInformationList: []models.TrackingInformation{
+		{
+			TrackingNo: "329014521622",
+			Scans: []models.Scan{
+				{
+					ScanType:             "Delivery",
+					TrackingNo:           "329014521622",
+					Depot:                "VANCOUVER",
+					ScanDate:             "2024-03-04",
+					ScanTime:             "101500",
+					Description:          "Shipment delivered to",
+					Comment:              "FRONT DOOR",
+					SummaryScanIndicator: true,
+				},
+			},
+		},
+	},
+}
+
+func Test_TrackPackagesByPin(t *testing.T) {
+	type args struct {
+		trackingNo string
+		client     HttpClient
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *models.TrackPackagesResponse
+		wantErr error
+	}{
+		{
+			name: "When gets a valid response, return the scans",
+			args: args{
+				trackingNo: "329014521622",
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(trackingResponseXML("TrackPackagesByPin")))),
+					},
+				},
+			},
+			want:    wantTracking,
+			wantErr: nil,
+		},
+		{
+			name: "When given an error on the response, return error",
+			args: args{
+				trackingNo: "329014521622",
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(trackingErrorXML("TrackPackagesByPin")))),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: ErrSoapResponse,
+		},
+		{
+			name: "When the response is an invalid XML, return error",
+			args: args{
+				trackingNo: "329014521622",
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(invalidXML))),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidXML,
+		},
+		{
+			name: "When the tracking number is missing, return error",
+			args: args{
+				trackingNo: "",
+				client:     MockHttpClient{},
+			},
+			want:    nil,
+			wantErr: ErrMissingTrackingNumber,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			soapClient := NewSoapClient("something", "somekey", tt.args.client)
+
+			got, err := soapClient.TrackPackagesByPin(context.Background(), tt.args.trackingNo)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap.TrackPackagesByPin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.TrackPackagesByPin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_TrackPackagesByReference(t *testing.T) {
+	type args struct {
+		reference string
+		client    HttpClient
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		want    *models.TrackPackagesResponse
+		wantErr error
+	}{
+		{
+			name: "When gets a valid response, return the scans",
+			args: args{
+				reference: "order-1234",
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(trackingResponseXML("TrackPackagesByReference")))),
+					},
+				},
+			},
+			want:    wantTracking,
+			wantErr: nil,
+		},
+		{
+			name: "When given an error on the response, return error",
+			args: args{
+				reference: "order-1234",
+				client: MockHttpClient{
+					response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader([]byte(trackingErrorXML("TrackPackagesByReference")))),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: ErrSoapResponse,
+		},
+		{
+			name: "When the reference is missing, return error",
+			args: args{
+				reference: "",
+				client:    MockHttpClient{},
+			},
+			want:    nil,
+			wantErr: ErrMissingReference,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			soapClient := NewSoapClient("something", "somekey", tt.args.client)
+
+			got, err := soapClient.TrackPackagesByReference(context.Background(), models.TrackPackageByReferenceSearchCriteria{
+				Reference:            tt.args.reference,
+				BillingAccountNumber: "9999999999",
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("soap.TrackPackagesByReference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("soap.TrackPackagesByReference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package soap
+
+import (
+	"math"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+const (
+	Pound      = "lb"
+	Kilogram   = "kg"
+	Inch       = "in"
+	Centimetre = "cm"
+
+	kilogramsPerPound  = 0.45359237
+	centimetresPerInch = 2.54
+
+	// unitPrecision keeps a single decimal, the values are rounded up so a
+	// package is never declared lighter or smaller than it is.
+	unitPrecision = 10
+)
+
+// Units are the weight and dimension units the Purolator account is configured for,
+// every weight and dimension is converted to them before it is sent.
+type Units struct {
+	Weight    string
+	Dimension string
+}
+
+var DefaultUnits = Units{Weight: Pound, Dimension: Inch}
+
+// WithUnits sets the units of the account, an empty unit keeps the default one.
+func WithUnits(units Units) Option {
+	return func(s *SoapClient) {
+		if len(units.Weight) > 0 {
+			s.units.Weight = units.Weight
+		}
+
+		if len(units.Dimension) > 0 {
+			s.units.Dimension = units.Dimension
+		}
+	}
+}
+
+// ConvertWeight converts a weight between pounds and kilograms,
+// it is returned as it is for the same or an unknown unit.
+func ConvertWeight(value float64, from, to string) float64 {
+	switch {
+	case from == Pound && to == Kilogram:
+		return value * kilogramsPerPound
+	case from == Kilogram && to == Pound:
+		return value / kilogramsPerPound
+	}
+
+	return value
+}
+
+// ConvertDimension converts a dimension between inches and centimetres,
+// it is returned as it is for the same or an unknown unit.
+func ConvertDimension(value float64, from, to string) float64 {
+	switch {
+	case from == Inch && to == Centimetre:
+		return value * centimetresPerInch
+	case from == Centimetre && to == Inch:
+		return value / centimetresPerInch
+	}
+
+	return value
+}
+
+// RoundUp rounds the value up to the precision taken by Purolator. The tolerance
+// keeps the float error of a conversion, like 12.700000001, from adding a step.
+func RoundUp(value float64) float64 {
+	return math.Ceil(value*unitPrecision-1e-6) / unitPrecision
+}
+
+func (u Units) weight(weight models.Weight) models.Weight {
+	value := ConvertWeight(float64(weight.Value), weight.WeightUnit, u.Weight)
+
+	return models.Weight{
+		Value:      models.Decimal(RoundUp(value)),
+		WeightUnit: u.Weight,
+	}
+}
+
+func (u Units) dimension(dimension *models.Dimension) *models.Dimension {
+	if dimension == nil {
+		return nil
+	}
+
+	value := ConvertDimension(float64(dimension.Value), dimension.DimensionUnit, u.Dimension)
+
+	return &models.Dimension{
+		Value:         models.Decimal(RoundUp(value)),
+		DimensionUnit: u.Dimension,
+	}
+}
+
+func (u Units) packageInformation(info *models.PackageInformation) {
+	info.TotalWeight = u.weight(info.TotalWeight)
+
+	if info.PiecesInformation == nil {
+		return
+	}
+
+	for i, piece := range info.PiecesInformation.Pieces {
+		info.PiecesInformation.Pieces[i] = models.Piece{
+			Weight: u.weight(piece.Weight),
+			Length: u.dimension(piece.Length),
+			Width:  u.dimension(piece.Width),
+			Height: u.dimension(piece.Height),
+		}
+	}
+}
+
+// shipment converts the packages of the shipment and of its return labels.
+func (u Units) shipment(shipment *models.Shipment) {
+	u.packageInformation(&shipment.PackageInformation)
+
+	if returns := shipment.ReturnShipmentInformation; returns != nil {
+		u.packageInformation(&returns.ReturnShipment.PackageInformation)
+	}
+}
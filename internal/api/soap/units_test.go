@@ -0,0 +1,89 @@
+package soap
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pesimista/purolator-rest-api/internal/api/models"
+)
+
+func Test_ConvertWeight(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value float64
+		from  string
+		to    string
+		want  float64
+	}{
+		{name: "When converting pounds to kilograms, rounds up to one decimal", value: 10, from: Pound, to: Kilogram, want: 4.6},
+		{name: "When converting kilograms to pounds, rounds up to one decimal", value: 2, from: Kilogram, to: Pound, want: 4.5},
+		{name: "When the units are the same, keep the value", value: 3.2, from: Pound, to: Pound, want: 3.2},
+		{name: "When the value has more decimals, rounds it up", value: 3.21, from: Kilogram, to: Kilogram, want: 3.3},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RoundUp(ConvertWeight(tt.value, tt.from, tt.to)); got != tt.want {
+				t.Errorf("ConvertWeight() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ConvertDimension(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value float64
+		from  string
+		to    string
+		want  float64
+	}{
+		{name: "When converting inches to centimetres, keep the exact decimal", value: 5, from: Inch, to: Centimetre, want: 12.7},
+		{name: "When converting centimetres to inches, rounds up to one decimal", value: 30, from: Centimetre, to: Inch, want: 11.9},
+		{name: "When the unit is unknown, keep the value", value: 7, from: "ft", to: Inch, want: 7},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RoundUp(ConvertDimension(tt.value, tt.from, tt.to)); got != tt.want {
+				t.Errorf("ConvertDimension() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_UnitsShipment(t *testing.T) {
+	units := Units{Weight: Kilogram, Dimension: Centimetre}
+
+	shipment := models.Shipment{
+		PackageInformation: models.PackageInformation{
+			TotalWeight: models.Weight{Value: 10, WeightUnit: Pound},
+			PiecesInformation: &models.PiecesInformation{
+				Pieces: []models.Piece{
+					{
+						Weight: models.Weight{Value: 10, WeightUnit: Pound},
+						Length: &models.Dimension{Value: 5, DimensionUnit: Inch},
+					},
+				},
+			},
+		},
+	}
+
+	units.shipment(&shipment)
+
+	want := models.PackageInformation{
+		TotalWeight: models.Weight{Value: 4.6, WeightUnit: Kilogram},
+		PiecesInformation: &models.PiecesInformation{
+			Pieces: []models.Piece{
+				{
+					Weight: models.Weight{Value: 4.6, WeightUnit: Kilogram},
+					Length: &models.Dimension{Value: 12.7, DimensionUnit: Centimetre},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(shipment.PackageInformation, want) {
+		t.Errorf("Units.shipment() = %+v, want %+v", shipment.PackageInformation, want)
+	}
+}
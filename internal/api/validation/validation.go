@@ -0,0 +1,210 @@
+package validation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/gin-gonic/gin"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+const (
+	invalidParameter   = "InvalidParameter"
+	invalidRequestBody = "InvalidRequestBody"
+
+	// inputKey keeps the validated request on the gin context for the response validation.
+	inputKey = "validation.input"
+)
+
+// Validator checks the requests and responses of the API against the OpenAPI spec.
+type Validator struct {
+	router  routers.Router
+	options *openapi3filter.Options
+}
+
+// NewValidator builds the validator for the spec served under baseURL, like /api/v1.
+func NewValidator(swagger *openapi3.T, baseURL string) (*Validator, error) {
+	const op string = "validation.NewValidator"
+
+	swagger.Servers = openapi3.Servers{{URL: baseURL}}
+
+	router, err := legacy.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Validator{
+		router: router,
+		options: &openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}, nil
+}
+
+// Request rejects the requests that do not match the spec with a 400 listing every
+// invalid field. It is meant for GinServerOptions.Middlewares.
+func (v *Validator) Request(c *gin.Context) {
+	route, pathParams, err := v.router.FindRoute(c.Request)
+	if err != nil {
+		// unknown routes are left to gin
+		return
+	}
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    c.Request,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    v.options,
+	}
+	c.Set(inputKey, input)
+
+	err = openapi3filter.ValidateRequest(c.Request.Context(), input)
+	if err == nil {
+		return
+	}
+
+	details := newErrorDetails(err)
+	c.AbortWithStatusJSON(http.StatusBadRequest, openapi.Error{
+		Code:    http.StatusBadRequest,
+		Message: "the request does not match the spec",
+		Errors:  &details,
+	})
+}
+
+// Response checks the JSON responses of the validated requests against the spec. A response
+// that does not match is still sent, the mismatch is added to the errors of the gin context
+// for the logger.
+func (v *Validator) Response(c *gin.Context) {
+	const op string = "validation.Response"
+
+	writer := &recorder{ResponseWriter: c.Writer, context: c}
+	c.Writer = writer
+
+	c.Next()
+
+	if !writer.capture {
+		return
+	}
+
+	value, _ := c.Get(inputKey)
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: value.(*openapi3filter.RequestValidationInput),
+		Status:                 writer.Status(),
+		Header:                 writer.Header(),
+		Options:                v.options,
+	}
+	input.SetBodyBytes(writer.body.Bytes())
+
+	if err := openapi3filter.ValidateResponse(context.WithoutCancel(c.Request.Context()), input); err != nil {
+		_ = c.Error(fmt.Errorf("%s: %s %s: %w", op, c.Request.Method, c.Request.URL.Path, err))
+	}
+}
+
+// recorder keeps a copy of the response body while it is written. The copy is only kept
+// when the route was validated and the response is JSON, it is decided on the first write
+// once the handler has set the headers.
+type recorder struct {
+	gin.ResponseWriter
+	context *gin.Context
+	decided bool
+	capture bool
+	body    bytes.Buffer
+}
+
+func (r *recorder) capturing() bool {
+	if !r.decided {
+		r.decided = true
+		_, validated := r.context.Get(inputKey)
+		r.capture = validated && strings.HasPrefix(r.Header().Get("Content-Type"), gin.MIMEJSON)
+	}
+
+	return r.capture
+}
+
+func (r *recorder) Write(data []byte) (int, error) {
+	if r.capturing() {
+		r.body.Write(data)
+	}
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *recorder) WriteString(data string) (int, error) {
+	if r.capturing() {
+		r.body.WriteString(data)
+	}
+	return r.ResponseWriter.WriteString(data)
+}
+
+// newErrorDetails lists one detail per invalid field found on the request.
+func newErrorDetails(err error) []openapi.ErrorDetail {
+	details := make([]openapi.ErrorDetail, 0)
+
+	// MultiError matches errors.As with any of its errors, so it is asserted instead
+	if multiErr, ok := err.(openapi3.MultiError); ok {
+		for _, err := range multiErr {
+			details = append(details, newErrorDetails(err)...)
+		}
+		return details
+	}
+
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err, &requestErr) {
+		return append(details, openapi.ErrorDetail{Code: invalidRequestBody, Description: err.Error()})
+	}
+
+	code, field := invalidRequestBody, ""
+	if requestErr.Parameter != nil {
+		code, field = invalidParameter, requestErr.Parameter.Name
+	}
+
+	schemaErrs := schemaErrors(requestErr.Err)
+	if len(schemaErrs) == 0 {
+		return append(details, newErrorDetail(code, field, requestErr.Error()))
+	}
+
+	for _, schemaErr := range schemaErrs {
+		schemaField := field
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			schemaField = strings.TrimPrefix(field+"/"+strings.Join(pointer, "/"), "/")
+		}
+
+		details = append(details, newErrorDetail(code, schemaField, schemaErr.Reason))
+	}
+
+	return details
+}
+
+func schemaErrors(err error) []*openapi3.SchemaError {
+	if multiErr, ok := err.(openapi3.MultiError); ok {
+		schemaErrs := make([]*openapi3.SchemaError, 0, len(multiErr))
+		for _, err := range multiErr {
+			schemaErrs = append(schemaErrs, schemaErrors(err)...)
+		}
+		return schemaErrs
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		return []*openapi3.SchemaError{schemaErr}
+	}
+
+	return nil
+}
+
+func newErrorDetail(code, field, description string) openapi.ErrorDetail {
+	detail := openapi.ErrorDetail{Code: code, Description: description}
+	if len(field) > 0 {
+		detail.Field = &field
+	}
+
+	return detail
+}
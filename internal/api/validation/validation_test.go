@@ -0,0 +1,249 @@
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pesimista/purolator-rest-api/internal/api/openapi"
+)
+
+// stubServer answers the handlers hit by the tests, the rest of the interface is left nil.
+type stubServer struct {
+	openapi.ServerInterface
+}
+
+func (stubServer) CreateShipment(c *gin.Context, params openapi.CreateShipmentParams) {
+	c.JSON(http.StatusCreated, openapi.CreateShipmentRes{
+		MasterTrackingNo: "329014521622",
+		TrackingNOs:      []string{"329014521622"},
+		Pieces:           make([]openapi.PiecePIN, 0),
+		Messages:         make([]openapi.InformationalMessage, 0),
+	})
+}
+
+func (stubServer) ListShipments(c *gin.Context, params openapi.ListShipmentsParams) {
+	c.JSON(http.StatusOK, openapi.ShipmentListRes{Shipments: make([]openapi.ShipmentRecord, 0)})
+}
+
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	swagger, err := openapi.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	validator, err := NewValidator(swagger, "/api/v1")
+	if err != nil {
+		t.Fatalf("NewValidator() error = %v", err)
+	}
+
+	router := gin.New()
+	router.Use(validator.Response)
+	openapi.RegisterHandlersWithOptions(router, stubServer{}, openapi.GinServerOptions{
+		BaseURL:     "/api/v1",
+		Middlewares: []openapi.MiddlewareFunc{validator.Request},
+	})
+
+	return router
+}
+
+// shipmentBody returns the shipment fixture after applying change to its sender address.
+func shipmentBody(t *testing.T, change func(address map[string]any)) string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("..", "soap", "testdata", "create_shipment.json"))
+	if err != nil {
+		t.Fatalf("could not read the shipment fixture: %v", err)
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(content, &body); err != nil {
+		t.Fatalf("could not parse the shipment fixture: %v", err)
+	}
+
+	shipment := body["shipment"].(map[string]any)
+	sender := shipment["senderInformation"].(map[string]any)
+	change(sender["address"].(map[string]any))
+
+	content, _ = json.Marshal(body)
+	return string(content)
+}
+
+func Test_Request(t *testing.T) {
+	router := newTestRouter(t)
+
+	testCases := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantFields []string
+	}{
+		{
+			name:       "When the shipment matches the spec, reach the handler",
+			method:     http.MethodPost,
+			target:     "/api/v1/shipments",
+			body:       shipmentBody(t, func(address map[string]any) {}),
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "When the sender name is too long and the phone is not numeric, list both fields",
+			method: http.MethodPost,
+			target: "/api/v1/shipments",
+			body: shipmentBody(t, func(address map[string]any) {
+				address["name"] = strings.Repeat("a", 31)
+				address["phoneNumber"].(map[string]any)["phone"] = "555-1234"
+			}),
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{
+				"shipment/senderInformation/address/name",
+				"shipment/senderInformation/address/phoneNumber/phone",
+			},
+		},
+		{
+			name:       "When a query parameter is out of range, name the parameter",
+			method:     http.MethodGet,
+			target:     "/api/v1/shipments?limit=0",
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"limit"},
+		},
+		{
+			name:       "When the query parameters match the spec, reach the handler",
+			method:     http.MethodGet,
+			target:     "/api/v1/shipments?limit=10&from=2024-03-01",
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("Request() status = %v, want %v: %s", recorder.Code, tt.wantStatus, recorder.Body.String())
+			}
+
+			if len(tt.wantFields) == 0 {
+				return
+			}
+
+			var res openapi.Error
+			if err := json.Unmarshal(recorder.Body.Bytes(), &res); err != nil || res.Errors == nil {
+				t.Fatalf("Request() returned an invalid body: %s", recorder.Body.String())
+			}
+
+			fields := make(map[string]bool)
+			for _, detail := range *res.Errors {
+				if detail.Field != nil {
+					fields[*detail.Field] = true
+				}
+			}
+
+			for _, field := range tt.wantFields {
+				if !fields[field] {
+					t.Errorf("Request() errors = %s, want field %q", recorder.Body.String(), field)
+				}
+			}
+		})
+	}
+}
+
+func Test_Response(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	swagger, err := openapi.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	validator, err := NewValidator(swagger, "/api/v1")
+	if err != nil {
+		t.Fatalf("NewValidator() error = %v", err)
+	}
+
+	testCases := []struct {
+		name        string
+		target      string
+		handler     gin.HandlerFunc
+		wantCapture bool
+		wantErrors  int
+	}{
+		{
+			name:   "When the response matches the spec, keep the body and log nothing",
+			target: "/api/v1/shipments",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusOK, openapi.ShipmentListRes{Shipments: make([]openapi.ShipmentRecord, 0)})
+			},
+			wantCapture: true,
+		},
+		{
+			name:   "When the response does not match the spec, add the mismatch to the errors",
+			target: "/api/v1/shipments",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"shipments": "none"})
+			},
+			wantCapture: true,
+			wantErrors:  1,
+		},
+		{
+			name:   "When the response is not JSON, do not keep the body",
+			target: "/api/v1/shipments",
+			handler: func(c *gin.Context) {
+				c.String(http.StatusOK, "no shipments")
+			},
+		},
+		{
+			name:   "When the route is not on the spec, do not keep the body",
+			target: "/health",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"status": "ok"})
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				writer *recorder
+				errs   []*gin.Error
+			)
+
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Next()
+				errs = c.Errors
+			})
+			router.Use(validator.Response)
+			router.GET(tt.target, validator.Request, tt.handler, func(c *gin.Context) {
+				writer = c.Writer.(*recorder)
+			})
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if writer == nil {
+				t.Fatalf("Response() did not wrap the writer")
+			}
+
+			if writer.capture != tt.wantCapture || (writer.body.Len() > 0) != tt.wantCapture {
+				t.Errorf("Response() kept %q, want a copy = %v", writer.body.String(), tt.wantCapture)
+			}
+
+			if len(errs) != tt.wantErrors {
+				t.Errorf("Response() errors = %v, want %d", errs, tt.wantErrors)
+			}
+		})
+	}
+}
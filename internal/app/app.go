@@ -1,13 +1,27 @@
 package app
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/pesimista/purolator-rest-api/internal/api"
+	"github.com/pesimista/purolator-rest-api/internal/config"
 )
 
 func Run() {
-	server := api.NewServer()
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("could not load config: %s\n", err)
+		os.Exit(1)
+	}
+
+	server := api.NewServer(cfg)
 	// server.CreateServer()
 
-	server.SetRoutes()
+	if err := server.SetRoutes(); err != nil {
+		fmt.Printf("could not set the routes: %s\n", err)
+		os.Exit(1)
+	}
+
 	server.Run()
 }
@@ -0,0 +1,269 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	Development = "development"
+	Production  = "production"
+
+	MemoryStore = "memory"
+	FileStore   = "file"
+
+	developmentBaseURL = "https://devwebservices.purolator.com"
+	productionBaseURL  = "https://webservices.purolator.com"
+
+	defaultWeightUnit    = "lb"
+	defaultDimensionUnit = "in"
+
+	defaultAddress = "localhost:8080"
+	defaultGroupID = "234521"
+
+	defaultIdempotencyTTL = 24 * time.Hour
+
+	defaultDatabasePath = "purolator.db"
+)
+
+var (
+	ErrInvalidConfigFile = errors.New("could not read config file")
+	ErrInvalidConfig     = errors.New("invalid config")
+)
+
+var accountRegex = regexp.MustCompile(`^\d+$`)
+
+type Config struct {
+	Server      Server      `yaml:"server"`
+	Purolator   Purolator   `yaml:"purolator"`
+	Idempotency Idempotency `yaml:"idempotency"`
+	Database    Database    `yaml:"database"`
+}
+
+type Server struct {
+	Address string `yaml:"address"`
+}
+
+// Idempotency selects where the responses of the Idempotency-Key requests are kept.
+type Idempotency struct {
+	// Store is either memory or file.
+	Store string `yaml:"store"`
+	// Path is the directory used by the file store.
+	Path string        `yaml:"path"`
+	TTL  time.Duration `yaml:"ttl"`
+}
+
+// Database is the SQLite file that keeps the shipments created through the API.
+type Database struct {
+	Path string `yaml:"path"`
+}
+
+type Purolator struct {
+	// Environment selects the Purolator base url, either development or production.
+	Environment    string `yaml:"environment"`
+	BaseURL        string `yaml:"baseURL"`
+	Key            string `yaml:"key"`
+	Secret         string `yaml:"secret"`
+	BillingAccount string `yaml:"billingAccount"`
+	GroupID        string `yaml:"groupID"`
+	// Timeouts for each kind of operation, like 30s or 1m. Zero keeps the soap client default.
+	Timeouts Timeouts `yaml:"timeouts"`
+	// Units the account is configured for, weights and dimensions are converted to them.
+	Units Units `yaml:"units"`
+}
+
+type Units struct {
+	// Weight is either lb or kg.
+	Weight string `yaml:"weight"`
+	// Dimension is either in or cm.
+	Dimension string `yaml:"dimension"`
+}
+
+type Timeouts struct {
+	Shipping   time.Duration `yaml:"shipping"`
+	Documents  time.Duration `yaml:"documents"`
+	Estimating time.Duration `yaml:"estimating"`
+	Tracking   time.Duration `yaml:"tracking"`
+	PickUp     time.Duration `yaml:"pickUp"`
+}
+
+// Load reads the config from the YAML file on CONFIG_FILE, when set,
+// then applies the environment variables on top of it and validates the result.
+func Load() (*Config, error) {
+	const op string = "config.Load"
+
+	config := &Config{}
+
+	if path := os.Getenv("CONFIG_FILE"); len(path) > 0 {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidConfigFile, err)
+		}
+
+		if err := yaml.Unmarshal(content, config); err != nil {
+			return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidConfigFile, err)
+		}
+	}
+
+	if err := config.loadEnv(); err != nil {
+		return nil, fmt.Errorf("%s: %w %w", op, ErrInvalidConfig, err)
+	}
+
+	config.setDefaults()
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return config, nil
+}
+
+func (c *Config) loadEnv() error {
+	setFromEnv(&c.Server.Address, "SERVER_ADDRESS")
+	setFromEnv(&c.Purolator.Environment, "PUROLATOR_ENVIRONMENT")
+	setFromEnv(&c.Purolator.BaseURL, "PUROLATOR_BASE_URL")
+	setFromEnv(&c.Purolator.Key, "PUROLATOR_KEY")
+	setFromEnv(&c.Purolator.Secret, "PUROLATOR_SECRET")
+	setFromEnv(&c.Purolator.BillingAccount, "PUROLATOR_BILLING_ACCOUNT")
+	setFromEnv(&c.Purolator.GroupID, "PUROLATOR_GROUP_ID")
+	setFromEnv(&c.Purolator.Units.Weight, "PUROLATOR_WEIGHT_UNIT")
+	setFromEnv(&c.Purolator.Units.Dimension, "PUROLATOR_DIMENSION_UNIT")
+	setFromEnv(&c.Idempotency.Store, "IDEMPOTENCY_STORE")
+	setFromEnv(&c.Idempotency.Path, "IDEMPOTENCY_PATH")
+	setFromEnv(&c.Database.Path, "DATABASE_PATH")
+
+	return errors.Join(
+		setDurationFromEnv(&c.Purolator.Timeouts.Shipping, "PUROLATOR_TIMEOUT_SHIPPING"),
+		setDurationFromEnv(&c.Purolator.Timeouts.Documents, "PUROLATOR_TIMEOUT_DOCUMENTS"),
+		setDurationFromEnv(&c.Purolator.Timeouts.Estimating, "PUROLATOR_TIMEOUT_ESTIMATING"),
+		setDurationFromEnv(&c.Purolator.Timeouts.Tracking, "PUROLATOR_TIMEOUT_TRACKING"),
+		setDurationFromEnv(&c.Purolator.Timeouts.PickUp, "PUROLATOR_TIMEOUT_PICKUP"),
+		setDurationFromEnv(&c.Idempotency.TTL, "IDEMPOTENCY_TTL"),
+	)
+}
+
+func (c *Config) setDefaults() {
+	if len(c.Server.Address) == 0 {
+		c.Server.Address = defaultAddress
+	}
+
+	if len(c.Purolator.Environment) == 0 {
+		c.Purolator.Environment = Development
+	}
+
+	if len(c.Purolator.BaseURL) == 0 {
+		c.Purolator.BaseURL = developmentBaseURL
+		if c.Purolator.Environment == Production {
+			c.Purolator.BaseURL = productionBaseURL
+		}
+	}
+
+	if len(c.Purolator.GroupID) == 0 {
+		c.Purolator.GroupID = defaultGroupID
+	}
+
+	if len(c.Purolator.Units.Weight) == 0 {
+		c.Purolator.Units.Weight = defaultWeightUnit
+	}
+
+	if len(c.Purolator.Units.Dimension) == 0 {
+		c.Purolator.Units.Dimension = defaultDimensionUnit
+	}
+
+	if len(c.Idempotency.Store) == 0 {
+		c.Idempotency.Store = MemoryStore
+	}
+
+	if c.Idempotency.TTL == 0 {
+		c.Idempotency.TTL = defaultIdempotencyTTL
+	}
+
+	if len(c.Database.Path) == 0 {
+		c.Database.Path = defaultDatabasePath
+	}
+}
+
+// Validate returns every problem found on the config at once.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.Purolator.Environment != Development && c.Purolator.Environment != Production {
+		errs = append(errs, fmt.Errorf("purolator environment must be %s or %s, got %q", Development, Production, c.Purolator.Environment))
+	}
+
+	if len(c.Purolator.Key) == 0 {
+		errs = append(errs, errors.New("missing purolator key"))
+	}
+
+	if len(c.Purolator.Secret) == 0 {
+		errs = append(errs, errors.New("missing purolator secret"))
+	}
+
+	if !accountRegex.MatchString(c.Purolator.BillingAccount) {
+		errs = append(errs, fmt.Errorf("purolator billing account must be numeric, got %q", c.Purolator.BillingAccount))
+	}
+
+	timeouts := []time.Duration{
+		c.Purolator.Timeouts.Shipping,
+		c.Purolator.Timeouts.Documents,
+		c.Purolator.Timeouts.Estimating,
+		c.Purolator.Timeouts.Tracking,
+		c.Purolator.Timeouts.PickUp,
+	}
+	for _, timeout := range timeouts {
+		if timeout < 0 {
+			errs = append(errs, fmt.Errorf("purolator timeouts can not be negative, got %s", timeout))
+			break
+		}
+	}
+
+	if units := c.Purolator.Units; units.Weight != "lb" && units.Weight != "kg" {
+		errs = append(errs, fmt.Errorf("purolator weight unit must be lb or kg, got %q", units.Weight))
+	}
+
+	if units := c.Purolator.Units; units.Dimension != "in" && units.Dimension != "cm" {
+		errs = append(errs, fmt.Errorf("purolator dimension unit must be in or cm, got %q", units.Dimension))
+	}
+
+	switch c.Idempotency.Store {
+	case MemoryStore:
+	case FileStore:
+		if len(c.Idempotency.Path) == 0 {
+			errs = append(errs, errors.New("missing idempotency path for the file store"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("idempotency store must be %s or %s, got %q", MemoryStore, FileStore, c.Idempotency.Store))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func setFromEnv(field *string, key string) {
+	if value, ok := os.LookupEnv(key); ok && len(value) > 0 {
+		*field = value
+	}
+}
+
+func setDurationFromEnv(field *time.Duration, key string) error {
+	value, ok := os.LookupEnv(key)
+	if !ok || len(value) == 0 {
+		return nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	*field = duration
+	return nil
+}
@@ -0,0 +1,276 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("could not write config file: %v", err)
+	}
+
+	return path
+}
+
+func Test_Load(t *testing.T) {
+	keys := []string{
+		"CONFIG_FILE",
+		"SERVER_ADDRESS",
+		"PUROLATOR_ENVIRONMENT",
+		"PUROLATOR_BASE_URL",
+		"PUROLATOR_KEY",
+		"PUROLATOR_SECRET",
+		"PUROLATOR_BILLING_ACCOUNT",
+		"PUROLATOR_GROUP_ID",
+		"PUROLATOR_WEIGHT_UNIT",
+		"PUROLATOR_DIMENSION_UNIT",
+		"PUROLATOR_TIMEOUT_SHIPPING",
+		"PUROLATOR_TIMEOUT_DOCUMENTS",
+		"PUROLATOR_TIMEOUT_ESTIMATING",
+		"PUROLATOR_TIMEOUT_TRACKING",
+		"PUROLATOR_TIMEOUT_PICKUP",
+		"IDEMPOTENCY_STORE",
+		"IDEMPOTENCY_PATH",
+		"IDEMPOTENCY_TTL",
+		"DATABASE_PATH",
+	}
+
+	tests := []struct {
+		name  string
+		file  string
+		env   map[string]string
+		want  *Config
+		error error
+	}{
+		{
+			name: "Should load the config from the environment with defaults",
+			env: map[string]string{
+				"PUROLATOR_KEY":             "key",
+				"PUROLATOR_SECRET":          "secret",
+				"PUROLATOR_BILLING_ACCOUNT": "9999999999",
+			},
+			want: &Config{
+				Server: Server{Address: defaultAddress},
+				Purolator: Purolator{
+					Environment:    Development,
+					BaseURL:        developmentBaseURL,
+					Key:            "key",
+					Secret:         "secret",
+					BillingAccount: "9999999999",
+					GroupID:        defaultGroupID,
+					Units:          Units{Weight: defaultWeightUnit, Dimension: defaultDimensionUnit},
+				},
+				Idempotency: Idempotency{Store: MemoryStore, TTL: defaultIdempotencyTTL},
+				Database:    Database{Path: defaultDatabasePath},
+			},
+		},
+		{
+			name: "Should use the production base url for the production environment",
+			env: map[string]string{
+				"PUROLATOR_ENVIRONMENT":     Production,
+				"PUROLATOR_KEY":             "key",
+				"PUROLATOR_SECRET":          "secret",
+				"PUROLATOR_BILLING_ACCOUNT": "9999999999",
+			},
+			want: &Config{
+				Server: Server{Address: defaultAddress},
+				Purolator: Purolator{
+					Environment:    Production,
+					BaseURL:        productionBaseURL,
+					Key:            "key",
+					Secret:         "secret",
+					BillingAccount: "9999999999",
+					GroupID:        defaultGroupID,
+					Units:          Units{Weight: defaultWeightUnit, Dimension: defaultDimensionUnit},
+				},
+				Idempotency: Idempotency{Store: MemoryStore, TTL: defaultIdempotencyTTL},
+				Database:    Database{Path: defaultDatabasePath},
+			},
+		},
+		{
+			name: "Should let the environment override the config file",
+			file: `
+server:
+  address: 0.0.0.0:9000
+purolator:
+  key: file-key
+  secret: file-secret
+  billingAccount: "1111111111"
+  groupID: "42"
+`,
+			env: map[string]string{
+				"PUROLATOR_KEY": "env-key",
+			},
+			want: &Config{
+				Server: Server{Address: "0.0.0.0:9000"},
+				Purolator: Purolator{
+					Environment:    Development,
+					BaseURL:        developmentBaseURL,
+					Key:            "env-key",
+					Secret:         "file-secret",
+					BillingAccount: "1111111111",
+					GroupID:        "42",
+					Units:          Units{Weight: defaultWeightUnit, Dimension: defaultDimensionUnit},
+				},
+				Idempotency: Idempotency{Store: MemoryStore, TTL: defaultIdempotencyTTL},
+				Database:    Database{Path: defaultDatabasePath},
+			},
+		},
+		{
+			name: "Should load the timeouts from the config file and the environment",
+			file: `
+purolator:
+  timeouts:
+    shipping: 1m
+    tracking: 5s
+`,
+			env: map[string]string{
+				"PUROLATOR_KEY":              "key",
+				"PUROLATOR_SECRET":           "secret",
+				"PUROLATOR_BILLING_ACCOUNT":  "9999999999",
+				"PUROLATOR_TIMEOUT_TRACKING": "2s",
+			},
+			want: &Config{
+				Server: Server{Address: defaultAddress},
+				Purolator: Purolator{
+					Environment:    Development,
+					BaseURL:        developmentBaseURL,
+					Key:            "key",
+					Secret:         "secret",
+					BillingAccount: "9999999999",
+					GroupID:        defaultGroupID,
+					Timeouts: Timeouts{
+						Shipping: time.Minute,
+						Tracking: 2 * time.Second,
+					},
+					Units: Units{Weight: defaultWeightUnit, Dimension: defaultDimensionUnit},
+				},
+				Idempotency: Idempotency{Store: MemoryStore, TTL: defaultIdempotencyTTL},
+				Database:    Database{Path: defaultDatabasePath},
+			},
+		},
+		{
+			name: "Should fail if a timeout is not a duration",
+			env: map[string]string{
+				"PUROLATOR_KEY":              "key",
+				"PUROLATOR_SECRET":           "secret",
+				"PUROLATOR_BILLING_ACCOUNT":  "9999999999",
+				"PUROLATOR_TIMEOUT_SHIPPING": "thirty",
+			},
+			error: ErrInvalidConfig,
+		},
+		{
+			name: "Should load the account units from the environment",
+			env: map[string]string{
+				"PUROLATOR_KEY":             "key",
+				"PUROLATOR_SECRET":          "secret",
+				"PUROLATOR_BILLING_ACCOUNT": "9999999999",
+				"PUROLATOR_WEIGHT_UNIT":     "kg",
+				"PUROLATOR_DIMENSION_UNIT":  "cm",
+			},
+			want: &Config{
+				Server: Server{Address: defaultAddress},
+				Purolator: Purolator{
+					Environment:    Development,
+					BaseURL:        developmentBaseURL,
+					Key:            "key",
+					Secret:         "secret",
+					BillingAccount: "9999999999",
+					GroupID:        defaultGroupID,
+					Units:          Units{Weight: "kg", Dimension: "cm"},
+				},
+				Idempotency: Idempotency{Store: MemoryStore, TTL: defaultIdempotencyTTL},
+				Database:    Database{Path: defaultDatabasePath},
+			},
+		},
+		{
+			name: "Should fail if the weight unit is unknown",
+			env: map[string]string{
+				"PUROLATOR_KEY":             "key",
+				"PUROLATOR_SECRET":          "secret",
+				"PUROLATOR_BILLING_ACCOUNT": "9999999999",
+				"PUROLATOR_WEIGHT_UNIT":     "oz",
+			},
+			error: ErrInvalidConfig,
+		},
+		{
+			name: "Should fail if the file idempotency store has no path",
+			env: map[string]string{
+				"PUROLATOR_KEY":             "key",
+				"PUROLATOR_SECRET":          "secret",
+				"PUROLATOR_BILLING_ACCOUNT": "9999999999",
+				"IDEMPOTENCY_STORE":         FileStore,
+			},
+			error: ErrInvalidConfig,
+		},
+		{
+			name: "Should fail if the config file does not exist",
+			env: map[string]string{
+				"CONFIG_FILE": "/does/not/exist.yaml",
+			},
+			error: ErrInvalidConfigFile,
+		},
+		{
+			name:  "Should fail if the config file is not valid YAML",
+			file:  "server: [",
+			error: ErrInvalidConfigFile,
+		},
+		{
+			name: "Should fail if the credentials are missing",
+			env: map[string]string{
+				"PUROLATOR_BILLING_ACCOUNT": "9999999999",
+			},
+			error: ErrInvalidConfig,
+		},
+		{
+			name: "Should fail if the billing account is not numeric",
+			env: map[string]string{
+				"PUROLATOR_KEY":             "key",
+				"PUROLATOR_SECRET":          "secret",
+				"PUROLATOR_BILLING_ACCOUNT": "ABC123",
+			},
+			error: ErrInvalidConfig,
+		},
+		{
+			name: "Should fail if the environment is unknown",
+			env: map[string]string{
+				"PUROLATOR_ENVIRONMENT":     "staging",
+				"PUROLATOR_KEY":             "key",
+				"PUROLATOR_SECRET":          "secret",
+				"PUROLATOR_BILLING_ACCOUNT": "9999999999",
+			},
+			error: ErrInvalidConfig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range keys {
+				t.Setenv(key, "")
+			}
+
+			if len(tt.file) > 0 {
+				t.Setenv("CONFIG_FILE", writeConfigFile(t, tt.file))
+			}
+
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			got, err := Load()
+			if !errors.Is(err, tt.error) {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.error)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Load() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}